@@ -22,3 +22,7 @@ output "region_join_token" {
 output "region_connected" {
   value = data.catalyst_region.region.connected
 }
+
+output "region_clusters" {
+  value = data.catalyst_region.region.clusters
+}
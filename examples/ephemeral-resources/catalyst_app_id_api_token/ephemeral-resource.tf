@@ -0,0 +1,20 @@
+# fetch the api token of an app id at apply time, without storing it in state
+ephemeral "catalyst_app_id_api_token" "orders" {
+  project = catalyst_project.project.name
+  app_id  = "orders"
+}
+
+# hand the token and endpoints to the workload through write-only attributes
+resource "kubernetes_secret_v1" "orders" {
+  metadata {
+    name      = "orders-dapr"
+    namespace = "orders"
+  }
+
+  data_wo = {
+    DAPR_API_TOKEN     = ephemeral.catalyst_app_id_api_token.orders.api_token
+    DAPR_GRPC_ENDPOINT = ephemeral.catalyst_app_id_api_token.orders.grpc_endpoint
+    DAPR_HTTP_ENDPOINT = ephemeral.catalyst_app_id_api_token.orders.http_endpoint
+  }
+  data_wo_revision = 1
+}
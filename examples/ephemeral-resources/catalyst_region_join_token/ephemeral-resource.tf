@@ -0,0 +1,17 @@
+# fetch the join token at apply time, without storing it in state
+ephemeral "catalyst_region_join_token" "region" {
+  region = catalyst_region.region.name
+}
+
+# hand it to the cluster through a write-only attribute
+resource "kubernetes_secret_v1" "join_token" {
+  metadata {
+    name      = "catalyst-join-token"
+    namespace = "cra-agent"
+  }
+
+  data_wo = {
+    join_token = ephemeral.catalyst_region_join_token.region.join_token
+  }
+  data_wo_revision = 1
+}
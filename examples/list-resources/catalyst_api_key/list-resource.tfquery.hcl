@@ -0,0 +1,9 @@
+# discover the CI keys, run with `terraform query -generate-config-out=api_keys.tf`
+list "catalyst_api_key" "ci" {
+  provider         = catalyst
+  include_resource = true
+
+  config {
+    name_prefix = "ci-"
+  }
+}
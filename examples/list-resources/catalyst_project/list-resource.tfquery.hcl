@@ -0,0 +1,10 @@
+# discover the projects of a region, run with `terraform query -generate-config-out=projects.tf`
+list "catalyst_project" "region" {
+  provider         = catalyst
+  include_resource = true
+
+  config {
+    region      = "my-region"
+    name_prefix = "prj-"
+  }
+}
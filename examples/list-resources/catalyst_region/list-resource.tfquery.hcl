@@ -0,0 +1,9 @@
+# discover the private regions, run with `terraform query -generate-config-out=regions.tf`
+list "catalyst_region" "private" {
+  provider         = catalyst
+  include_resource = true
+
+  config {
+    type = "private"
+  }
+}
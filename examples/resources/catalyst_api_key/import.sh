@@ -0,0 +1,15 @@
+# using the API key identifier, the token can't be recovered on import
+terraform import catalyst_api_key.ci 00000000-0000-0000-0000-000000000000
+
+# using organization ID and API key identifier
+terraform import catalyst_api_key.ci 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000000
+
+# with Terraform 1.12 and later, import blocks can also use the identity:
+#
+# import {
+#   to = catalyst_api_key.ci
+#   identity = {
+#     organization_id = "00000000-0000-0000-0000-000000000000"
+#     id              = "00000000-0000-0000-0000-000000000000"
+#   }
+# }
@@ -0,0 +1,12 @@
+output "api_key_id" {
+  value = catalyst_api_key.ci.id
+}
+
+output "api_key_token" {
+  value     = catalyst_api_key.ci.token
+  sensitive = true
+}
+
+output "api_key_expires_at" {
+  value = catalyst_api_key.ci.expires_at
+}
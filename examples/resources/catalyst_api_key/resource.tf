@@ -0,0 +1,8 @@
+resource "catalyst_api_key" "ci" {
+  name       = "ci"
+  role       = "cra.diagrid:editor"
+  expires_in = "720h"
+
+  # replace the key on the first apply during its last week
+  rotate_before = "168h"
+}
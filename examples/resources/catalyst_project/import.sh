@@ -1,2 +1,15 @@
 # using name
 terraform import catalyst_project.project prj1
+
+# using organization ID and name
+terraform import catalyst_project.project 00000000-0000-0000-0000-000000000000/prj1
+
+# with Terraform 1.12 and later, import blocks can also use the identity:
+#
+# import {
+#   to = catalyst_project.project
+#   identity = {
+#     organization_id = "00000000-0000-0000-0000-000000000000"
+#     name            = "prj1"
+#   }
+# }
@@ -27,3 +27,7 @@ output "region_connected" {
   value = catalyst_region.region.connected
 }
 
+
+output "region_clusters" {
+  value = catalyst_region.region.clusters
+}
@@ -6,5 +6,6 @@ const (
 	KindProject = "Project"
 	KindRegion  = "Region"
 
+	RegionTypePublic  = "public"
 	RegionTypePrivate = "private"
 )
@@ -0,0 +1,312 @@
+package catalyst
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+)
+
+// DefaultCacheTTL is how long reads are cached by default.
+const DefaultCacheTTL = 5 * time.Second
+
+// sharedReadTimeout bounds a read shared by several callers, which runs
+// without the deadline of any of them.
+const sharedReadTimeout = time.Minute
+
+// CacheStats counts the reads served by a caching client.
+type CacheStats struct {
+	// Hits are the reads served from the cache, or joining an identical
+	// read in flight.
+	Hits int64
+	// Misses are the reads sent to the API.
+	Misses int64
+}
+
+// CachingClient is a Client caching its reads.
+type CachingClient interface {
+	Client
+
+	// Stats returns the hits and misses of the cache so far.
+	Stats() CacheStats
+}
+
+type freshKey struct{}
+
+// Fresh returns a context whose reads through a caching client bypass the
+// cache, while still refreshing it. Polls waiting for objects to change
+// read with it.
+func Fresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshKey{}, true)
+}
+
+func isFresh(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshKey{}).(bool)
+	return fresh
+}
+
+// cachingClient caches the reads of a client for a short time, and
+// coalesces identical reads in flight into a single request, so the many
+// resources and data sources of a plan reading the same objects don't all
+// reach the API. Writes invalidate what they change.
+type cachingClient struct {
+	client Client
+	ttl    time.Duration
+
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	// generation is incremented by every invalidation, so reads in flight
+	// while an object changes aren't cached.
+	generation uint64
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// NewCachingClient returns a client caching the reads of client for ttl.
+func NewCachingClient(client Client, ttl time.Duration) CachingClient {
+	return &cachingClient{
+		client:  client,
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *cachingClient) Stats() CacheStats {
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// cached returns the cached value of the key, or reads it with fn once for
+// every caller waiting on the key. The shared read isn't canceled with the
+// caller starting it, while every caller stops waiting when its own context
+// ends. Values are cloned so callers can't change each other's.
+func cached[T any](ctx context.Context, c *cachingClient, key string, fn func(context.Context) (T, error)) (T, error) {
+	if !isFresh(ctx) {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		c.mu.Unlock()
+		if value, isT := entry.value.(T); ok && isT && time.Now().Before(entry.expires) {
+			c.log(ctx, "client cache hit", key, c.hits.Add(1), c.misses.Load())
+			return clone(value), nil
+		}
+	}
+
+	read := func(ctx context.Context) (any, error) {
+		c.mu.Lock()
+		generation := c.generation
+		c.mu.Unlock()
+
+		c.log(ctx, "client cache miss", key, c.hits.Load(), c.misses.Add(1))
+		v, err := fn(ctx)
+		if err != nil {
+			return v, err
+		}
+
+		c.mu.Lock()
+		if generation == c.generation {
+			c.entries[key] = cacheEntry{value: v, expires: time.Now().Add(c.ttl)}
+		}
+		c.mu.Unlock()
+
+		return v, nil
+	}
+
+	var (
+		v      any
+		err    error
+		shared bool
+	)
+	if isFresh(ctx) {
+		v, err = read(ctx)
+	} else {
+		ch := c.group.DoChan(key, func() (any, error) {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedReadTimeout)
+			defer cancel()
+
+			return read(ctx)
+		})
+
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case res := <-ch:
+			v, err, shared = res.Val, res.Err, res.Shared
+		}
+	}
+	if shared {
+		c.log(ctx, "client cache hit", key, c.hits.Add(1), c.misses.Load())
+	}
+
+	value, _ := v.(T)
+	return clone(value), err
+}
+
+// invalidate drops the cached keys, and every key under them.
+func (c *cachingClient) invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key := range c.entries {
+		for _, k := range keys {
+			if key == k || strings.HasPrefix(key, k+"/") {
+				delete(c.entries, key)
+			}
+		}
+	}
+}
+
+func (c *cachingClient) log(ctx context.Context, msg, key string, hits, misses int64) {
+	tflog.Debug(ctx, msg,
+		map[string]interface{}{
+			"key":    key,
+			"hits":   hits,
+			"misses": misses,
+		})
+}
+
+// clone deep copies an API object through its JSON representation.
+func clone[T any](v T) T {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var copied T
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return v
+	}
+
+	return copied
+}
+
+func (c *cachingClient) GetUserOrg(ctx context.Context) (*conductor_client.Organization, error) {
+	return cached(ctx, c, "org", func(ctx context.Context) (*conductor_client.Organization, error) {
+		return c.client.GetUserOrg(ctx)
+	})
+}
+
+func (c *cachingClient) CreateAPIKey(ctx context.Context, req *conductor_client.APIKeyRequest) (*conductor_client.APIKey, error) {
+	defer c.invalidate("apikeys")
+	return c.client.CreateAPIKey(ctx, req)
+}
+
+func (c *cachingClient) GetAPIKey(ctx context.Context, id string) (*conductor_client.APIKey, error) {
+	return cached(ctx, c, "apikeys/"+id, func(ctx context.Context) (*conductor_client.APIKey, error) {
+		return c.client.GetAPIKey(ctx, id)
+	})
+}
+
+func (c *cachingClient) ListAPIKeys(ctx context.Context) ([]conductor_client.APIKey, error) {
+	return cached(ctx, c, "apikeys", func(ctx context.Context) ([]conductor_client.APIKey, error) {
+		return c.client.ListAPIKeys(ctx)
+	})
+}
+
+func (c *cachingClient) DeleteAPIKey(ctx context.Context, id string) error {
+	defer c.invalidate("apikeys")
+	return c.client.DeleteAPIKey(ctx, id)
+}
+
+func (c *cachingClient) CreateRegion(ctx context.Context, region *cloudruntime_client.Region) (string, error) {
+	defer c.invalidate("regions", "region/"+regionName(region))
+	return c.client.CreateRegion(ctx, region)
+}
+
+func (c *cachingClient) GetRegion(ctx context.Context, name string) (*cloudruntime_client.Region, error) {
+	return cached(ctx, c, "region/"+name, func(ctx context.Context) (*cloudruntime_client.Region, error) {
+		return c.client.GetRegion(ctx, name)
+	})
+}
+
+func (c *cachingClient) ListRegions(ctx context.Context) ([]cloudruntime_client.Region, error) {
+	return cached(ctx, c, "regions", func(ctx context.Context) ([]cloudruntime_client.Region, error) {
+		return c.client.ListRegions(ctx)
+	})
+}
+
+// GetRegionJoinToken isn't cached, as join tokens are secrets.
+func (c *cachingClient) GetRegionJoinToken(ctx context.Context, name string) (string, error) {
+	return c.client.GetRegionJoinToken(ctx, name)
+}
+
+func (c *cachingClient) UpdateRegion(ctx context.Context, region *cloudruntime_client.Region) error {
+	defer c.invalidate("regions", "region/"+regionName(region))
+	return c.client.UpdateRegion(ctx, region)
+}
+
+func (c *cachingClient) DeleteRegion(ctx context.Context, name string) error {
+	defer c.invalidate("regions", "region/"+name)
+	return c.client.DeleteRegion(ctx, name)
+}
+
+func (c *cachingClient) GetProject(ctx context.Context, id string, qp *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+	// the parameters change the project returned
+	params, err := json.Marshal(qp)
+	if err != nil {
+		return c.client.GetProject(ctx, id, qp)
+	}
+
+	return cached(ctx, c, "project/"+id+"/"+string(params), func(ctx context.Context) (*cloudruntime_client.Project, error) {
+		return c.client.GetProject(ctx, id, qp)
+	})
+}
+
+func (c *cachingClient) ListProjects(ctx context.Context) ([]cloudruntime_client.Project, error) {
+	return cached(ctx, c, "projects", func(ctx context.Context) ([]cloudruntime_client.Project, error) {
+		return c.client.ListProjects(ctx)
+	})
+}
+
+func (c *cachingClient) CreateProject(ctx context.Context, project *cloudruntime_client.Project) error {
+	defer c.invalidate("projects", "project/"+projectName(project))
+	return c.client.CreateProject(ctx, project)
+}
+
+func (c *cachingClient) UpdateProject(ctx context.Context, project *cloudruntime_client.Project) error {
+	defer c.invalidate("projects", "project/"+projectName(project))
+	return c.client.UpdateProject(ctx, project)
+}
+
+func (c *cachingClient) DeleteProject(ctx context.Context, id string) error {
+	defer c.invalidate("projects", "project/"+id)
+	return c.client.DeleteProject(ctx, id)
+}
+
+// GetAppIDAPIToken isn't cached, as API tokens are secrets.
+func (c *cachingClient) GetAppIDAPIToken(ctx context.Context, project, appID string) (string, error) {
+	return c.client.GetAppIDAPIToken(ctx, project, appID)
+}
+
+func regionName(region *cloudruntime_client.Region) string {
+	if region == nil || region.Metadata == nil || region.Metadata.Name == nil {
+		return ""
+	}
+	return *region.Metadata.Name
+}
+
+func projectName(project *cloudruntime_client.Project) string {
+	if project == nil || project.Metadata == nil || project.Metadata.Name == nil {
+		return ""
+	}
+	return *project.Metadata.Name
+}
@@ -0,0 +1,216 @@
+package catalyst_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/mock/gomock"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+)
+
+func newProject(name, status string) *cloudruntime_client.Project {
+	return &cloudruntime_client.Project{
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Status: &cloudruntime_client.ProjectStatus{
+			Status: lo.ToPtr(status),
+		},
+	}
+}
+
+func getProject(t *testing.T, ctx context.Context, c catalyst.Client, name string) *cloudruntime_client.Project {
+	t.Helper()
+
+	project, err := c.GetProject(ctx, name, &cloudruntime_client.DescribeProjectParams{})
+	if err != nil {
+		t.Fatalf("getting project %s: %s", name, err)
+	}
+
+	return project
+}
+
+func TestCachingClientCachesReads(t *testing.T) {
+	ctx := context.Background()
+	m := catalyst.NewMockClient(gomock.NewController(t))
+	m.EXPECT().
+		GetProject(gomock.Any(), "prj", gomock.Any()).
+		Return(newProject("prj", "ready"), nil).
+		Times(1)
+
+	c := catalyst.NewCachingClient(m, time.Minute)
+	for range 3 {
+		getProject(t, ctx, c, "prj")
+	}
+
+	// callers can't change the cached project
+	getProject(t, ctx, c, "prj").Status.Status = lo.ToPtr("changed")
+	if status := *getProject(t, ctx, c, "prj").Status.Status; status != "ready" {
+		t.Errorf("expected the cached project to be unchanged, got status %s", status)
+	}
+
+	if stats := c.Stats(); stats.Hits != 4 || stats.Misses != 1 {
+		t.Errorf("expected 4 hits and 1 miss, got %+v", stats)
+	}
+}
+
+func TestCachingClientCoalescesReads(t *testing.T) {
+	ctx := context.Background()
+	m := catalyst.NewMockClient(gomock.NewController(t))
+	m.EXPECT().
+		ListProjects(gomock.Any()).
+		DoAndReturn(func(context.Context) ([]cloudruntime_client.Project, error) {
+			time.Sleep(100 * time.Millisecond)
+			return []cloudruntime_client.Project{*newProject("prj", "ready")}, nil
+		}).
+		Times(1)
+
+	c := catalyst.NewCachingClient(m, time.Minute)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			projects, err := c.ListProjects(ctx)
+			if err != nil || len(projects) != 1 {
+				t.Errorf("expected a project, got %v: %v", projects, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCachingClientCoalescedReadOutlivesCaller(t *testing.T) {
+	m := catalyst.NewMockClient(gomock.NewController(t))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	m.EXPECT().
+		ListProjects(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) ([]cloudruntime_client.Project, error) {
+			close(started)
+			<-release
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return []cloudruntime_client.Project{*newProject("prj", "ready")}, nil
+		}).
+		Times(1)
+
+	c := catalyst.NewCachingClient(m, time.Minute)
+
+	// the caller starting the read gives up on it
+	first, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := c.ListProjects(first)
+		canceled <- err
+	}()
+	<-started
+
+	joined := make(chan error, 1)
+	go func() {
+		projects, err := c.ListProjects(context.Background())
+		if err == nil && len(projects) != 1 {
+			err = fmt.Errorf("expected a project, got %v", projects)
+		}
+		joined <- err
+	}()
+
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the first caller to stop waiting, got %v", err)
+	}
+
+	// the callers still waiting get the result of the read
+	close(release)
+	if err := <-joined; err != nil {
+		t.Errorf("expected the joining caller to get the projects, got %s", err)
+	}
+}
+
+func TestCachingClientExpires(t *testing.T) {
+	ctx := context.Background()
+	m := catalyst.NewMockClient(gomock.NewController(t))
+	m.EXPECT().
+		GetProject(gomock.Any(), "prj", gomock.Any()).
+		Return(newProject("prj", "ready"), nil).
+		Times(2)
+
+	c := catalyst.NewCachingClient(m, 50*time.Millisecond)
+	getProject(t, ctx, c, "prj")
+	time.Sleep(100 * time.Millisecond)
+	getProject(t, ctx, c, "prj")
+}
+
+func TestCachingClientInvalidatesOnWrites(t *testing.T) {
+	ctx := context.Background()
+	m := catalyst.NewMockClient(gomock.NewController(t))
+
+	status := "ready"
+	m.EXPECT().
+		GetProject(gomock.Any(), "prj", gomock.Any()).
+		DoAndReturn(func(context.Context, string, *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+			return newProject("prj", status), nil
+		}).
+		Times(2)
+	m.EXPECT().
+		GetProject(gomock.Any(), "other", gomock.Any()).
+		Return(newProject("other", "ready"), nil).
+		Times(1)
+	m.EXPECT().
+		UpdateProject(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *cloudruntime_client.Project) error {
+			status = "processing"
+			return nil
+		})
+
+	c := catalyst.NewCachingClient(m, time.Minute)
+	getProject(t, ctx, c, "prj")
+	getProject(t, ctx, c, "other")
+
+	if err := c.UpdateProject(ctx, newProject("prj", "ready")); err != nil {
+		t.Fatalf("updating project: %s", err)
+	}
+
+	// only the updated project is read again
+	if status := *getProject(t, ctx, c, "prj").Status.Status; status != "processing" {
+		t.Errorf("expected the updated project, got status %s", status)
+	}
+	getProject(t, ctx, c, "other")
+}
+
+func TestCachingClientFresh(t *testing.T) {
+	ctx := context.Background()
+	m := catalyst.NewMockClient(gomock.NewController(t))
+
+	status := "processing"
+	m.EXPECT().
+		GetProject(gomock.Any(), "prj", gomock.Any()).
+		DoAndReturn(func(context.Context, string, *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+			return newProject("prj", status), nil
+		}).
+		Times(2)
+
+	c := catalyst.NewCachingClient(m, time.Minute)
+	getProject(t, ctx, c, "prj")
+
+	// fresh reads bypass the cache and refresh it
+	status = "ready"
+	if status := *getProject(t, catalyst.Fresh(ctx), c, "prj").Status.Status; status != "ready" {
+		t.Errorf("expected a fresh read, got status %s", status)
+	}
+	if status := *getProject(t, ctx, c, "prj").Status.Status; status != "ready" {
+		t.Errorf("expected the cache to be refreshed, got status %s", status)
+	}
+}
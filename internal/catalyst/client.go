@@ -14,15 +14,25 @@ import (
 type Client interface {
 	GetUserOrg(context.Context) (*conductor_client.Organization, error)
 
+	CreateAPIKey(ctx context.Context, req *conductor_client.APIKeyRequest) (*conductor_client.APIKey, error)
+	GetAPIKey(ctx context.Context, id string) (*conductor_client.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]conductor_client.APIKey, error)
+	DeleteAPIKey(ctx context.Context, id string) error
+
 	CreateRegion(ctx context.Context, region *cloudruntime_client.Region) (string, error)
 	GetRegion(ctx context.Context, name string) (*cloudruntime_client.Region, error)
+	ListRegions(ctx context.Context) ([]cloudruntime_client.Region, error)
+	GetRegionJoinToken(ctx context.Context, name string) (string, error)
 	UpdateRegion(ctx context.Context, region *cloudruntime_client.Region) error
 	DeleteRegion(ctx context.Context, name string) error
 
 	GetProject(ctx context.Context, id string, qp *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error)
+	ListProjects(ctx context.Context) ([]cloudruntime_client.Project, error)
 	CreateProject(ctx context.Context, project *cloudruntime_client.Project) error
 	UpdateProject(ctx context.Context, prj *cloudruntime_client.Project) error
 	DeleteProject(ctx context.Context, id string) error
+
+	GetAppIDAPIToken(ctx context.Context, project, appID string) (string, error)
 }
 
 type cclient struct {
@@ -36,6 +46,12 @@ var (
 )
 
 func NewClient(endpoint, apiKey string) (Client, error) {
+	return NewClientWithHTTPClient(endpoint, apiKey, http.DefaultClient)
+}
+
+// NewClientWithHTTPClient creates a client sending every request through
+// httpClient, so tests can record or replay the API interactions.
+func NewClientWithHTTPClient(endpoint, apiKey string, httpClient *http.Client) (Client, error) {
 	if apiKey == "" {
 		return nil, ErrAPIKeyNotFound
 	}
@@ -43,9 +59,12 @@ func NewClient(endpoint, apiKey string) (Client, error) {
 		return nil, ErrEndpointNotFound
 	}
 
+	// keep the error responses the SDK reduces to their status
+	httpClient = withCapture(httpClient)
+
 	// Example client configuration for data sources and resources
 	maxRetries := 1
-	mc, err := management.NewManagementClientWithExponentialBackoff(http.DefaultClient,
+	mc, err := management.NewManagementClientWithExponentialBackoff(httpClient,
 		endpoint,
 		maxRetries,
 		management.WithAPIKeyToken(apiKey))
@@ -53,7 +72,7 @@ func NewClient(endpoint, apiKey string) (Client, error) {
 		return nil, fmt.Errorf("error creating management client: %w", err)
 	}
 
-	catalystClient, err := cloudruntime.NewCloudruntimeClientWithExponentialBackoff(http.DefaultClient,
+	catalystClient, err := cloudruntime.NewCloudruntimeClientWithExponentialBackoff(httpClient,
 		endpoint,
 		maxRetries,
 		cloudruntime.WithAPIKeyToken(apiKey))
@@ -61,29 +80,102 @@ func NewClient(endpoint, apiKey string) (Client, error) {
 		return nil, fmt.Errorf("error creating catalyst client: %w", err)
 	}
 
-	return &cclient{
-		management: mc,
-		catalyst:   catalystClient,
+	return &errorClient{
+		client: &cclient{
+			management: mc,
+			catalyst:   catalystClient,
+		},
 	}, nil
 }
 
 func (c *cclient) GetUserOrg(ctx context.Context) (*conductor_client.Organization, error) {
-	// find the current user's organization id
-	user, err := c.management.GetCurrentUser(ctx)
+	orgID, err := c.userOrgID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error getting user org: %w", err)
+		return nil, err
 	}
 
 	// now fetch the org
-	org, err := c.management.GetUserOrg(ctx, *user.Data.Attributes.Organization.Id)
+	org, err := c.management.GetUserOrg(ctx, orgID)
 	if err != nil {
-		return nil, fmt.Errorf("error getting user org %s: %w",
-			*user.Data.Attributes.Organization.Id, err)
+		return nil, fmt.Errorf("error getting user org %s: %w", orgID, err)
 	}
 
 	return org, nil
 }
 
+// userOrgID finds the current user's organization id.
+func (c *cclient) userOrgID(ctx context.Context) (string, error) {
+	user, err := c.management.GetCurrentUser(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting user org: %w", err)
+	}
+	if user == nil || user.Data.Attributes.Organization.Id == nil {
+		return "", fmt.Errorf("error getting user org: user has no organization")
+	}
+
+	return *user.Data.Attributes.Organization.Id, nil
+}
+
+func (c *cclient) CreateAPIKey(ctx context.Context, req *conductor_client.APIKeyRequest) (*conductor_client.APIKey, error) {
+	orgID, err := c.userOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.management.CreateAPIKey(ctx, orgID, req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating api key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (c *cclient) GetAPIKey(ctx context.Context, id string) (*conductor_client.APIKey, error) {
+	orgID, err := c.userOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.management.GetAPIKey(ctx, orgID, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting api key %s: %w", id, err)
+	}
+
+	return key, nil
+}
+
+func (c *cclient) ListAPIKeys(ctx context.Context) ([]conductor_client.APIKey, error) {
+	orgID, err := c.userOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := c.management.ListAPIKeys(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing api keys: %w", err)
+	}
+
+	keys := make([]conductor_client.APIKey, 0, len(list.Data))
+	for _, data := range list.Data {
+		keys = append(keys, conductor_client.APIKey{Data: data})
+	}
+
+	return keys, nil
+}
+
+func (c *cclient) DeleteAPIKey(ctx context.Context, id string) error {
+	orgID, err := c.userOrgID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.management.DeleteAPIKey(ctx, orgID, id); err != nil {
+		return fmt.Errorf("error deleting api key %s: %w", id, err)
+	}
+
+	return nil
+}
+
 func (c *cclient) CreateRegion(ctx context.Context, region *cloudruntime_client.Region) (string, error) {
 	resp, err := c.catalyst.CreatePrivateRegion(ctx, region)
 	if err != nil {
@@ -105,6 +197,30 @@ func (c *cclient) GetRegion(ctx context.Context, name string) (*cloudruntime_cli
 	return region, nil
 }
 
+func (c *cclient) ListRegions(ctx context.Context) ([]cloudruntime_client.Region, error) {
+	regions, err := c.catalyst.ListRegions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing regions: %w", err)
+	}
+	if regions == nil || regions.Items == nil {
+		return nil, nil
+	}
+
+	return *regions.Items, nil
+}
+
+func (c *cclient) GetRegionJoinToken(ctx context.Context, name string) (string, error) {
+	resp, err := c.catalyst.GetPrivateRegionJoinToken(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("error getting join token for region %s: %w", name, err)
+	}
+	if resp == nil || resp.JoinToken == nil || *resp.JoinToken == "" {
+		return "", fmt.Errorf("error getting join token for region %s: join token is empty", name)
+	}
+
+	return *resp.JoinToken, nil
+}
+
 func (c *cclient) UpdateRegion(ctx context.Context, region *cloudruntime_client.Region) error {
 	if err := c.catalyst.PutPrivateRegion(ctx, *region.Metadata.Name, region); err != nil {
 		return fmt.Errorf("error updating region %s: %w", *region.Metadata.Name, err)
@@ -128,6 +244,18 @@ func (c *cclient) GetProject(ctx context.Context, id string, qp *cloudruntime_cl
 	return project, nil
 }
 
+func (c *cclient) ListProjects(ctx context.Context) ([]cloudruntime_client.Project, error) {
+	projects, err := c.catalyst.ListProjects(ctx, &cloudruntime_client.ListProjectsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+	if projects == nil || projects.Items == nil {
+		return nil, nil
+	}
+
+	return *projects.Items, nil
+}
+
 func (c *cclient) CreateProject(ctx context.Context, project *cloudruntime_client.Project) error {
 	if err := c.catalyst.CreateProject(ctx, project); err != nil {
 		return fmt.Errorf("error creating project: %w", err)
@@ -151,3 +279,15 @@ func (c *cclient) DeleteProject(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (c *cclient) GetAppIDAPIToken(ctx context.Context, project, appID string) (string, error) {
+	resp, err := c.catalyst.GetAppIDAPIToken(ctx, project, appID)
+	if err != nil {
+		return "", fmt.Errorf("error getting api token for app id %s in project %s: %w", appID, project, err)
+	}
+	if resp == nil || resp.Token == nil || *resp.Token == "" {
+		return "", fmt.Errorf("error getting api token for app id %s in project %s: token is empty", appID, project)
+	}
+
+	return *resp.Token, nil
+}
@@ -43,6 +43,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockClient) CreateAPIKey(ctx context.Context, req *client0.APIKeyRequest) (*client0.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, req)
+	ret0, _ := ret[0].(*client0.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockClientMockRecorder) CreateAPIKey(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockClient)(nil).CreateAPIKey), ctx, req)
+}
+
 // CreateProject mocks base method.
 func (m *MockClient) CreateProject(ctx context.Context, project *client.Project) error {
 	m.ctrl.T.Helper()
@@ -72,6 +87,20 @@ func (mr *MockClientMockRecorder) CreateRegion(ctx, region any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegion", reflect.TypeOf((*MockClient)(nil).CreateRegion), ctx, region)
 }
 
+// DeleteAPIKey mocks base method.
+func (m *MockClient) DeleteAPIKey(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockClientMockRecorder) DeleteAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockClient)(nil).DeleteAPIKey), ctx, id)
+}
+
 // DeleteProject mocks base method.
 func (m *MockClient) DeleteProject(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -100,6 +129,36 @@ func (mr *MockClientMockRecorder) DeleteRegion(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegion", reflect.TypeOf((*MockClient)(nil).DeleteRegion), ctx, name)
 }
 
+// GetAPIKey mocks base method.
+func (m *MockClient) GetAPIKey(ctx context.Context, id string) (*client0.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, id)
+	ret0, _ := ret[0].(*client0.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockClientMockRecorder) GetAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockClient)(nil).GetAPIKey), ctx, id)
+}
+
+// GetAppIDAPIToken mocks base method.
+func (m *MockClient) GetAppIDAPIToken(ctx context.Context, project, appID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppIDAPIToken", ctx, project, appID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppIDAPIToken indicates an expected call of GetAppIDAPIToken.
+func (mr *MockClientMockRecorder) GetAppIDAPIToken(ctx, project, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppIDAPIToken", reflect.TypeOf((*MockClient)(nil).GetAppIDAPIToken), ctx, project, appID)
+}
+
 // GetProject mocks base method.
 func (m *MockClient) GetProject(ctx context.Context, id string, qp *client.DescribeProjectParams) (*client.Project, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +189,21 @@ func (mr *MockClientMockRecorder) GetRegion(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockClient)(nil).GetRegion), ctx, name)
 }
 
+// GetRegionJoinToken mocks base method.
+func (m *MockClient) GetRegionJoinToken(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionJoinToken", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionJoinToken indicates an expected call of GetRegionJoinToken.
+func (mr *MockClientMockRecorder) GetRegionJoinToken(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionJoinToken", reflect.TypeOf((*MockClient)(nil).GetRegionJoinToken), ctx, name)
+}
+
 // GetUserOrg mocks base method.
 func (m *MockClient) GetUserOrg(arg0 context.Context) (*client0.Organization, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +219,51 @@ func (mr *MockClientMockRecorder) GetUserOrg(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOrg", reflect.TypeOf((*MockClient)(nil).GetUserOrg), arg0)
 }
 
+// ListAPIKeys mocks base method.
+func (m *MockClient) ListAPIKeys(ctx context.Context) ([]client0.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx)
+	ret0, _ := ret[0].([]client0.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockClientMockRecorder) ListAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockClient)(nil).ListAPIKeys), ctx)
+}
+
+// ListProjects mocks base method.
+func (m *MockClient) ListProjects(ctx context.Context) ([]client.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx)
+	ret0, _ := ret[0].([]client.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockClientMockRecorder) ListProjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockClient)(nil).ListProjects), ctx)
+}
+
+// ListRegions mocks base method.
+func (m *MockClient) ListRegions(ctx context.Context) ([]client.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegions", ctx)
+	ret0, _ := ret[0].([]client.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegions indicates an expected call of ListRegions.
+func (mr *MockClientMockRecorder) ListRegions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockClient)(nil).ListRegions), ctx)
+}
+
 // UpdateProject mocks base method.
 func (m *MockClient) UpdateProject(ctx context.Context, prj *client.Project) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,286 @@
+package catalyst
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+)
+
+// APIError is an error response of the Catalyst API, wrapping the error
+// the SDK returned for it with what the response tells about the failure.
+type APIError struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is the error code of the API, if any.
+	Code string
+	// Message is the error message of the API, if any.
+	Message string
+	// RequestID identifies the request in the logs of the API, if any.
+	RequestID string
+	// Fields are the messages of the invalid fields of the request, by
+	// field path such as "spec.region".
+	Fields map[string]string
+
+	// Err is the error returned by the SDK.
+	Err error
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" || strings.Contains(e.Err.Error(), e.Message) {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", e.Err, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// requestIDHeaders are the response headers the request ID is read from.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Request-Id"}
+
+// errorResponse is the last error response received for the requests made
+// with a context.
+type errorResponse struct {
+	mu     sync.Mutex
+	status int
+	header http.Header
+	body   []byte
+}
+
+type errorResponseKey struct{}
+
+// captureTransport keeps the error responses of requests, which the SDK
+// reduces to their status, so they can be turned into an APIError.
+type captureTransport struct {
+	next http.RoundTripper
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	captured, ok := req.Context().Value(errorResponseKey{}).(*errorResponse)
+	if !ok {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	captured.mu.Lock()
+	defer captured.mu.Unlock()
+
+	captured.status = resp.StatusCode
+	captured.header = resp.Header
+	captured.body = body
+
+	return resp, nil
+}
+
+// withCapture wraps the transport of the HTTP client to retry throttled
+// requests and capture the error responses left once retries are exhausted.
+func withCapture(httpClient *http.Client) *http.Client {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	captured := *httpClient
+	captured.Transport = &captureTransport{next: newRetryTransport(transport)}
+
+	return &captured
+}
+
+// capture returns a context capturing the error responses of its requests,
+// and a function turning an error returned for them into an APIError.
+func capture(ctx context.Context) (context.Context, func(error) error) {
+	captured := &errorResponse{}
+
+	return context.WithValue(ctx, errorResponseKey{}, captured), func(err error) error {
+		captured.mu.Lock()
+		defer captured.mu.Unlock()
+
+		if err == nil || captured.status == 0 {
+			return err
+		}
+
+		return newAPIError(captured.status, captured.header, captured.body, err)
+	}
+}
+
+// newAPIError reads the error response, which is expected to be a JSON
+// object such as {"code": "...", "message": "...", "fields": {...}}.
+func newAPIError(status int, header http.Header, body []byte, err error) *APIError {
+	apiErr := &APIError{
+		StatusCode: status,
+		Err:        err,
+	}
+	for _, h := range requestIDHeaders {
+		if id := header.Get(h); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	var parsed struct {
+		Code      any               `json:"code"`
+		Error     string            `json:"error"`
+		Message   string            `json:"message"`
+		Detail    string            `json:"detail"`
+		RequestID string            `json:"requestId"`
+		Fields    map[string]string `json:"fields"`
+		Details   []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"details"`
+	}
+	if json.Unmarshal(body, &parsed) != nil {
+		return apiErr
+	}
+
+	// numeric codes only repeat the status
+	if code, ok := parsed.Code.(string); ok {
+		apiErr.Code = code
+	}
+	for _, message := range []string{parsed.Message, parsed.Detail, parsed.Error} {
+		if message != "" && message != http.StatusText(status) {
+			apiErr.Message = message
+			break
+		}
+	}
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = parsed.RequestID
+	}
+	if len(parsed.Fields) > 0 {
+		apiErr.Fields = parsed.Fields
+	}
+	for _, d := range parsed.Details {
+		if d.Field == "" {
+			continue
+		}
+		if apiErr.Fields == nil {
+			apiErr.Fields = make(map[string]string)
+		}
+		apiErr.Fields[d.Field] = d.Message
+	}
+
+	return apiErr
+}
+
+// errorClient returns the errors of the API responses of a client as
+// APIError.
+type errorClient struct {
+	client Client
+}
+
+func (c *errorClient) GetUserOrg(ctx context.Context) (*conductor_client.Organization, error) {
+	ctx, wrap := capture(ctx)
+	org, err := c.client.GetUserOrg(ctx)
+	return org, wrap(err)
+}
+
+func (c *errorClient) CreateAPIKey(ctx context.Context, req *conductor_client.APIKeyRequest) (*conductor_client.APIKey, error) {
+	ctx, wrap := capture(ctx)
+	key, err := c.client.CreateAPIKey(ctx, req)
+	return key, wrap(err)
+}
+
+func (c *errorClient) GetAPIKey(ctx context.Context, id string) (*conductor_client.APIKey, error) {
+	ctx, wrap := capture(ctx)
+	key, err := c.client.GetAPIKey(ctx, id)
+	return key, wrap(err)
+}
+
+func (c *errorClient) ListAPIKeys(ctx context.Context) ([]conductor_client.APIKey, error) {
+	ctx, wrap := capture(ctx)
+	keys, err := c.client.ListAPIKeys(ctx)
+	return keys, wrap(err)
+}
+
+func (c *errorClient) DeleteAPIKey(ctx context.Context, id string) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.DeleteAPIKey(ctx, id))
+}
+
+func (c *errorClient) CreateRegion(ctx context.Context, region *cloudruntime_client.Region) (string, error) {
+	ctx, wrap := capture(ctx)
+	token, err := c.client.CreateRegion(ctx, region)
+	return token, wrap(err)
+}
+
+func (c *errorClient) GetRegion(ctx context.Context, name string) (*cloudruntime_client.Region, error) {
+	ctx, wrap := capture(ctx)
+	region, err := c.client.GetRegion(ctx, name)
+	return region, wrap(err)
+}
+
+func (c *errorClient) ListRegions(ctx context.Context) ([]cloudruntime_client.Region, error) {
+	ctx, wrap := capture(ctx)
+	regions, err := c.client.ListRegions(ctx)
+	return regions, wrap(err)
+}
+
+func (c *errorClient) GetRegionJoinToken(ctx context.Context, name string) (string, error) {
+	ctx, wrap := capture(ctx)
+	token, err := c.client.GetRegionJoinToken(ctx, name)
+	return token, wrap(err)
+}
+
+func (c *errorClient) UpdateRegion(ctx context.Context, region *cloudruntime_client.Region) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.UpdateRegion(ctx, region))
+}
+
+func (c *errorClient) DeleteRegion(ctx context.Context, name string) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.DeleteRegion(ctx, name))
+}
+
+func (c *errorClient) GetProject(ctx context.Context, id string, qp *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+	ctx, wrap := capture(ctx)
+	project, err := c.client.GetProject(ctx, id, qp)
+	return project, wrap(err)
+}
+
+func (c *errorClient) ListProjects(ctx context.Context) ([]cloudruntime_client.Project, error) {
+	ctx, wrap := capture(ctx)
+	projects, err := c.client.ListProjects(ctx)
+	return projects, wrap(err)
+}
+
+func (c *errorClient) CreateProject(ctx context.Context, project *cloudruntime_client.Project) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.CreateProject(ctx, project))
+}
+
+func (c *errorClient) UpdateProject(ctx context.Context, project *cloudruntime_client.Project) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.UpdateProject(ctx, project))
+}
+
+func (c *errorClient) DeleteProject(ctx context.Context, id string) error {
+	ctx, wrap := capture(ctx)
+	return wrap(c.client.DeleteProject(ctx, id))
+}
+
+func (c *errorClient) GetAppIDAPIToken(ctx context.Context, project, appID string) (string, error) {
+	ctx, wrap := capture(ctx)
+	token, err := c.client.GetAppIDAPIToken(ctx, project, appID)
+	return token, wrap(err)
+}
@@ -0,0 +1,98 @@
+package catalyst
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetries is how many times a request the API throttled or
+	// couldn't serve for the moment is retried.
+	DefaultRetries = 3
+	// DefaultRetryWait is how long to wait before the first retry when the
+	// response doesn't tell, doubling on every retry.
+	DefaultRetryWait = time.Second
+	// MaxRetryWait caps how long a response can ask to wait before a retry.
+	MaxRetryWait = 30 * time.Second
+)
+
+// retryTransport retries the requests answered with 429 Too Many Requests
+// or 503 Service Unavailable, waiting as long as the Retry-After header of
+// the response asks.
+type retryTransport struct {
+	next http.RoundTripper
+
+	retries int
+	wait    time.Duration
+	maxWait time.Duration
+}
+
+// newRetryTransport returns a transport retrying the requests of next with
+// the default settings.
+func newRetryTransport(next http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		next:    next,
+		retries: DefaultRetries,
+		wait:    DefaultRetryWait,
+		maxWait: MaxRetryWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	wait := t.wait
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= t.retries || !retryable(resp.StatusCode) {
+			return resp, err
+		}
+
+		// the body was sent with the failed attempt, so it is only retried
+		// when it can be sent again
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := retryAfter(resp.Header, wait, t.maxWait)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+		wait *= 2
+	}
+}
+
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+// retryAfter returns how long the response asks to wait, as seconds or as
+// a date in its Retry-After header, capped to maxWait, or wait when it
+// doesn't tell.
+func retryAfter(header http.Header, wait, maxWait time.Duration) time.Duration {
+	delay := wait
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(v); err == nil {
+			delay = time.Until(date)
+		}
+	}
+
+	return min(max(delay, 0), maxWait)
+}
@@ -0,0 +1,104 @@
+package catalyst
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		switch len(bodies) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	attempts := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(bodies)
+	}
+
+	transport := newRetryTransport(http.DefaultTransport)
+	transport.wait = time.Millisecond
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("region"))
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("expected the request to be retried until created, got %d", resp.StatusCode)
+	}
+	for i, body := range attempts() {
+		if body != "region" {
+			t.Errorf("expected attempt %d to send the body, got %q", i+1, body)
+		}
+	}
+
+	// the last response is returned once retries are exhausted
+	transport.retries = 0
+	mu.Lock()
+	bodies = nil
+	mu.Unlock()
+	req, err = http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+	resp, err = transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+	_ = resp.Body.Close()
+	if n := len(attempts()); resp.StatusCode != http.StatusTooManyRequests || n != 1 {
+		t.Errorf("expected a single throttled attempt, got %d after %d attempts", resp.StatusCode, n)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		header   string
+		expected time.Duration
+	}{
+		{"", time.Second},
+		{"2", 2 * time.Second},
+		{"-1", 0},
+		{"3600", MaxRetryWait},
+		{"soon", time.Second},
+	} {
+		header := http.Header{}
+		if tc.header != "" {
+			header.Set("Retry-After", tc.header)
+		}
+		if delay := retryAfter(header, time.Second, MaxRetryWait); delay != tc.expected {
+			t.Errorf("expected to wait %s for %q, got %s", tc.expected, tc.header, delay)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Package apierrors translates the errors of the Catalyst API into
+// diagnostics telling users what went wrong and what to do about it:
+// invalid fields are reported on the attributes they were set from, and
+// authentication, permission, quota and rate limit failures come with
+// hints, along with the HTTP status, error code and request ID to quote
+// when reaching out to support.
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/samber/lo"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+)
+
+// Error is what is known of an error returned by the Catalyst API.
+type Error struct {
+	// StatusCode is the HTTP status of the response, zero when unknown.
+	StatusCode int
+	// Code is the error code of the API, if any.
+	Code string
+	// Message is the error message of the API, if any.
+	Message string
+	// RequestID identifies the request in the logs of the API, if any.
+	RequestID string
+	// Fields are the messages of the invalid fields of the request, by
+	// field path such as "spec.region".
+	Fields map[string]string
+}
+
+// The interfaces errors of other clients may implement to tell about the
+// response they were returned for.
+type (
+	statusCoder interface{ StatusCode() int }
+	errorCoder  interface{ ErrorCode() string }
+	requestIDer interface{ RequestID() string }
+)
+
+// Parse returns what err tells about the API response it was returned for,
+// from the response captured by the client, or the methods of the error.
+func Parse(err error) Error {
+	var apiErr *catalyst.APIError
+	if errors.As(err, &apiErr) {
+		return Error{
+			StatusCode: apiErr.StatusCode,
+			Code:       apiErr.Code,
+			Message:    apiErr.Message,
+			RequestID:  apiErr.RequestID,
+			Fields:     apiErr.Fields,
+		}
+	}
+
+	var (
+		e  Error
+		sc statusCoder
+		ec errorCoder
+		ri requestIDer
+	)
+	if errors.As(err, &sc) {
+		e.StatusCode = sc.StatusCode()
+	} else if diagrid_errors.IsResourceNotFoundError(err) {
+		e.StatusCode = http.StatusNotFound
+	}
+	if errors.As(err, &ec) {
+		e.Code = ec.ErrorCode()
+	}
+	if errors.As(err, &ri) {
+		e.RequestID = ri.RequestID()
+	}
+
+	return e
+}
+
+// Option configures how errors are translated.
+type Option func(*options)
+
+type options struct {
+	attributes map[string]path.Path
+	attribute  *path.Path
+}
+
+// WithAttributes maps the fields of API objects, such as "spec.region", to
+// the attributes they are set from, so invalid fields are reported on the
+// attributes.
+func WithAttributes(attributes map[string]path.Path) Option {
+	return func(o *options) {
+		o.attributes = attributes
+	}
+}
+
+// AtAttribute reports the error on the attribute, for requests made for the
+// value of a single attribute.
+func AtAttribute(attribute path.Path) Option {
+	return func(o *options) {
+		o.attribute = &attribute
+	}
+}
+
+// AddError adds the diagnostics of err to diags. what describes what
+// failed, such as "Error creating project", and starts every detail.
+func AddError(diags *diag.Diagnostics, err error, what string, opts ...Option) {
+	diags.Append(Diagnostics(err, what, opts...)...)
+}
+
+// Diagnostics returns the diagnostics of err, see AddError.
+func Diagnostics(err error, what string, opts ...Option) diag.Diagnostics {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	e := Parse(err)
+	detail := fmt.Sprintf("%s: %s", what, err)
+	summary, hint := e.explain(err)
+
+	var diags diag.Diagnostics
+	if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity {
+		// report the invalid fields on their attributes, and the others in
+		// the detail of the error
+		var unmapped []string
+		fields := lo.Keys(e.Fields)
+		sort.Strings(fields)
+		for _, field := range fields {
+			attribute, ok := o.attributes[field]
+			if !ok {
+				unmapped = append(unmapped, fmt.Sprintf("%s: %s", field, e.Fields[field]))
+				continue
+			}
+			diags.AddAttributeError(attribute, "Invalid Attribute Value",
+				fmt.Sprintf("%s: %s%s", what, e.Fields[field], e.reference()))
+		}
+		if len(unmapped) > 0 {
+			hint = "Invalid fields:\n  " + strings.Join(unmapped, "\n  ")
+		}
+		if len(unmapped) == 0 && diags.HasError() {
+			return diags
+		}
+	}
+
+	if hint != "" {
+		detail += "\n\n" + hint
+	}
+	if o.attribute != nil {
+		diags.AddAttributeError(*o.attribute, summary, detail+e.reference())
+		return diags
+	}
+	diags.AddError(summary, detail+e.reference())
+
+	return diags
+}
+
+// explain returns the summary of the error, and a hint to resolve it.
+func (e Error) explain(err error) (summary, hint string) {
+	quota := strings.Contains(strings.ToLower(e.Code+" "+e.Message), "quota")
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "Operation Timed Out",
+			"The operation didn't complete in time. It may still complete in Catalyst: " +
+				"raise the timeouts of the resource, and apply again."
+	case e.StatusCode == http.StatusUnauthorized:
+		return "Authentication Failed",
+			"Catalyst rejected the API key. Check that the api_key attribute of the provider, " +
+				"or the CATALYST_API_KEY environment variable, holds a valid API key that hasn't expired."
+	case e.StatusCode == http.StatusForbidden:
+		return "Permission Denied",
+			"The API key isn't allowed to do this. Check the roles of the API key set with the api_key " +
+				"attribute of the provider or the CATALYST_API_KEY environment variable, and that it " +
+				"belongs to the organization of the object."
+	case e.StatusCode == http.StatusPaymentRequired ||
+		(quota && (e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusConflict)):
+		return "Quota Exceeded",
+			"The plan of the organization doesn't allow more of these objects. Delete unused ones, " +
+				"or upgrade the plan of the organization."
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "Rate Limited",
+			"Catalyst is throttling requests. Apply again later, or lower the -parallelism of Terraform."
+	case e.StatusCode == http.StatusConflict:
+		return "Conflict",
+			"The object already exists, or is being changed by another operation. Import the existing " +
+				"object, or apply again once the other operation completes."
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity:
+		return "Invalid Request", ""
+	case e.StatusCode >= http.StatusInternalServerError:
+		return "Catalyst API Error",
+			"Catalyst failed to serve the request, which is usually temporary. Apply again later."
+	default:
+		return "Client Error", ""
+	}
+}
+
+// reference returns what identifies the failed request, to quote when
+// reaching out to support.
+func (e Error) reference() string {
+	var parts []string
+	if e.StatusCode != 0 {
+		parts = append(parts, fmt.Sprintf("HTTP status %d", e.StatusCode))
+	}
+	if e.Code != "" {
+		parts = append(parts, "error code "+e.Code)
+	}
+	if e.RequestID != "" {
+		parts = append(parts, "request ID "+e.RequestID)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return "\n\n(" + strings.Join(parts, ", ") + ")"
+}
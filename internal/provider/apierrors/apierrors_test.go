@@ -0,0 +1,144 @@
+package apierrors_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+)
+
+func apiError(status int, code, message string, fields map[string]string) error {
+	return &catalyst.APIError{
+		StatusCode: status,
+		Code:       code,
+		Message:    message,
+		RequestID:  "req-1",
+		Fields:     fields,
+		Err:        diagrid_errors.NewDiagridCloudError(status),
+	}
+}
+
+func TestDiagnosticsHints(t *testing.T) {
+	for _, tc := range []struct {
+		err     error
+		summary string
+		hint    string
+	}{
+		{apiError(http.StatusUnauthorized, "", "", nil), "Authentication Failed", "CATALYST_API_KEY"},
+		{apiError(http.StatusForbidden, "", "", nil), "Permission Denied", "roles of the API key"},
+		{apiError(http.StatusTooManyRequests, "QuotaExceeded", "", nil), "Quota Exceeded", "upgrade the plan"},
+		{apiError(http.StatusTooManyRequests, "", "rate limit exceeded", nil), "Rate Limited", "-parallelism"},
+		{apiError(http.StatusConflict, "", "", nil), "Conflict", "Import the existing object"},
+		{apiError(http.StatusBadGateway, "", "", nil), "Catalyst API Error", "usually temporary"},
+		{fmt.Errorf("waiting: %w", context.DeadlineExceeded), "Operation Timed Out", "raise the timeouts"},
+		{errors.New("connection refused"), "Client Error", ""},
+	} {
+		diags := apierrors.Diagnostics(tc.err, "Error creating project")
+		if len(diags) != 1 {
+			t.Fatalf("expected a single diagnostic for %s, got %v", tc.err, diags)
+		}
+
+		d := diags[0]
+		if d.Summary() != tc.summary {
+			t.Errorf("expected summary %q for %s, got %q", tc.summary, tc.err, d.Summary())
+		}
+		if !strings.HasPrefix(d.Detail(), "Error creating project: ") {
+			t.Errorf("expected the detail to start with what failed, got %q", d.Detail())
+		}
+		if !strings.Contains(d.Detail(), tc.hint) {
+			t.Errorf("expected the detail to contain %q, got %q", tc.hint, d.Detail())
+		}
+	}
+}
+
+func TestDiagnosticsReference(t *testing.T) {
+	diags := apierrors.Diagnostics(apiError(http.StatusConflict, "AlreadyExists", "project already exists", nil),
+		"Error creating project")
+
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, "project already exists") {
+		t.Errorf("expected the detail to contain the API message, got %q", detail)
+	}
+	if !strings.HasSuffix(detail, "(HTTP status 409, error code AlreadyExists, request ID req-1)") {
+		t.Errorf("expected the detail to end with the reference of the request, got %q", detail)
+	}
+}
+
+func TestDiagnosticsAttributes(t *testing.T) {
+	err := apiError(http.StatusUnprocessableEntity, "", "", map[string]string{
+		"spec.region":  "region does not exist",
+		"spec.unknown": "is not allowed",
+	})
+	attributes := apierrors.WithAttributes(map[string]path.Path{
+		"spec.region": path.Root("region"),
+	})
+
+	diags := apierrors.Diagnostics(err, "Error creating project", attributes)
+	if len(diags) != 2 {
+		t.Fatalf("expected an attribute and a general diagnostic, got %v", diags)
+	}
+
+	var attribute, general bool
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		switch {
+		case ok && withPath.Path().Equal(path.Root("region")):
+			attribute = strings.Contains(d.Detail(), "region does not exist")
+		case !ok:
+			// unmapped fields are listed in the general error
+			general = d.Summary() == "Invalid Request" &&
+				strings.Contains(d.Detail(), "spec.unknown: is not allowed")
+		}
+	}
+	if !attribute || !general {
+		t.Errorf("expected the region field on its attribute and the unknown field in the error, got %v", diags)
+	}
+
+	// only mapped fields don't need a general error
+	err = apiError(http.StatusBadRequest, "", "", map[string]string{
+		"spec.region": "region does not exist",
+	})
+	if diags := apierrors.Diagnostics(err, "Error creating project", attributes); len(diags) != 1 {
+		t.Errorf("expected only the attribute diagnostic, got %v", diags)
+	}
+}
+
+func TestDiagnosticsAtAttribute(t *testing.T) {
+	diags := apierrors.Diagnostics(apiError(http.StatusForbidden, "", "", nil), "error getting region",
+		apierrors.AtAttribute(path.Root("region")))
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("region")) {
+		t.Errorf("expected the error on the region attribute, got %v", diags)
+	}
+}
+
+// statusError is an error of another client, telling about its response.
+type statusError struct{}
+
+func (statusError) Error() string     { return "forbidden" }
+func (statusError) StatusCode() int   { return http.StatusForbidden }
+func (statusError) ErrorCode() string { return "Forbidden" }
+func (statusError) RequestID() string { return "abc" }
+
+func TestParse(t *testing.T) {
+	e := apierrors.Parse(fmt.Errorf("reading: %w", statusError{}))
+	if e.StatusCode != http.StatusForbidden || e.Code != "Forbidden" || e.RequestID != "abc" {
+		t.Errorf("expected the response of the error, got %+v", e)
+	}
+
+	e = apierrors.Parse(diagrid_errors.NewDiagridCloudError(http.StatusNotFound))
+	if e.StatusCode != http.StatusNotFound {
+		t.Errorf("expected not found errors to be 404, got %+v", e)
+	}
+}
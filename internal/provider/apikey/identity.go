@@ -0,0 +1,134 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// identity is the identity of an API key. Unlike other Catalyst objects,
+// keys are addressed by their identifier rather than their name, which
+// isn't unique.
+type identity struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ID             types.String `tfsdk:"id"`
+}
+
+func identitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_id": identityschema.StringAttribute{
+				Description:       "Identifier of the organization the API key belongs to",
+				OptionalForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "API key identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity stores the identity of the key in the response identity,
+// carrying the organization over from the prior identity when known.
+// Nothing is stored when Terraform doesn't support resource identity.
+func setIdentity(ctx context.Context,
+	client catalyst.Client,
+	prior *tfsdk.ResourceIdentity,
+	resp *tfsdk.ResourceIdentity,
+	id string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if resp == nil {
+		return diags
+	}
+
+	var model identity
+	if prior != nil && !prior.Raw.IsNull() {
+		diags.Append(prior.Get(ctx, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if model.OrganizationID.IsNull() || model.OrganizationID.IsUnknown() {
+		orgID, err := helpers.OrganizationID(ctx, client)
+		if err != nil {
+			apierrors.AddError(&diags, err, "Error getting organization")
+			return diags
+		}
+		model.OrganizationID = types.StringValue(orgID)
+	}
+	model.ID = types.StringValue(id)
+
+	diags.Append(resp.Set(ctx, model)...)
+	return diags
+}
+
+// importIdentity resolves the key being imported, either from an import ID
+// of the form `<organization>/<id>` or `<id>`, or from the identity in an
+// import block. The organization, when given, must be the one the
+// configured API key belongs to.
+func importIdentity(ctx context.Context,
+	client catalyst.Client,
+	req resource.ImportStateRequest,
+) (identity, diag.Diagnostics) {
+	var (
+		model identity
+		diags diag.Diagnostics
+	)
+
+	if req.ID != "" {
+		orgID, id, found := strings.Cut(req.ID, "/")
+		if !found {
+			orgID, id = "", req.ID
+		}
+		if id == "" || strings.Contains(id, "/") {
+			diags.AddError("Invalid Import ID",
+				fmt.Sprintf("Expected an import ID of the form <id> or <organization>/<id>, got %q", req.ID))
+			return model, diags
+		}
+
+		model.ID = types.StringValue(id)
+		if orgID != "" {
+			model.OrganizationID = types.StringValue(orgID)
+		}
+	} else if req.Identity != nil {
+		diags.Append(req.Identity.Get(ctx, &model)...)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	if model.ID.ValueString() == "" {
+		diags.AddError("Invalid Import Identity",
+			"The identifier of the API key to import must be set")
+		return model, diags
+	}
+
+	orgID, err := helpers.OrganizationID(ctx, client)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error getting organization")
+		return model, diags
+	}
+
+	if given := model.OrganizationID.ValueString(); given != "" && given != orgID {
+		diags.AddError("Organization Mismatch",
+			fmt.Sprintf("Cannot import API key %q from organization %q, the configured API key belongs to organization %q",
+				model.ID.ValueString(), given, orgID))
+		return model, diags
+	}
+	model.OrganizationID = types.StringValue(orgID)
+
+	return model, diags
+}
@@ -0,0 +1,150 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &apiKeyListResource{}
+var _ list.ListResourceWithConfigure = &apiKeyListResource{}
+
+// apiKeyListResource enumerates the API keys of the organization.
+type apiKeyListResource struct {
+	client catalyst.Client
+}
+
+// listModel describes the filters of the list resource.
+type listModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func NewListResource() list.ListResource {
+	return &apiKeyListResource{}
+}
+
+func (p *apiKeyListResource) Metadata(ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (p *apiKeyListResource) ListResourceConfigSchema(ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Catalyst API keys of the organization",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list API keys whose name starts with this prefix",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *apiKeyListResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	p.client = providerData.Client
+}
+
+func (p *apiKeyListResource) List(ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter listModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	orgID, err := helpers.OrganizationID(ctx, p.client)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error getting organization")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	keys, err := p.client.ListAPIKeys(ctx)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error listing api keys")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "listed api keys", map[string]interface{}{
+		"count":       len(keys),
+		"name_prefix": filter.NamePrefix.ValueString(),
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, key := range keys {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			id := lo.FromPtr(key.Data.Id)
+			name := lo.FromPtr(lo.FromPtr(key.Data.Attributes).Name)
+			if id == "" ||
+				!strings.HasPrefix(name, filter.NamePrefix.ValueString()) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(result.Identity.Set(ctx, identity{
+				OrganizationID: types.StringValue(orgID),
+				ID:             types.StringValue(id),
+			})...)
+
+			if req.IncludeResource {
+				model := NewResourceModel()
+				model.Role = types.StringUnknown()
+				model.Scopes = types.SetUnknown(types.StringType)
+				model.Token = types.StringNull()
+				result.Diagnostics.Append(model.fromAPIKey(ctx, &key)...)
+				model.ExpiresIn = model.lifetime()
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package apikey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+	"go.uber.org/mock/gomock"
+
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apikey"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+)
+
+func TestMockAPIKeyListResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := catalyst.NewMockClient(ctrl)
+
+	c.EXPECT().
+		GetUserOrg(gomock.Any()).
+		Return(&conductor_client.Organization{
+			Data: conductor_client.OrganizationData{
+				Id: lo.ToPtr(orgID),
+			},
+		}, nil).
+		AnyTimes()
+
+	c.EXPECT().
+		ListAPIKeys(gomock.Any()).
+		Return([]conductor_client.APIKey{
+			newListedAPIKey("key-a", "ci-a"),
+			newListedAPIKey("key-b", "ci-b"),
+			newListedAPIKey("key-c", "other"),
+			// keys without an identifier can't be addressed and are skipped
+			{Data: conductor_client.APIKeyData{Attributes: &conductor_client.APIKeyAttributes{Name: lo.ToPtr("ci-d")}}},
+		}, nil).
+		AnyTimes()
+
+	tests := []struct {
+		name       string
+		namePrefix *string
+		limit      int64
+		expected   []string
+	}{
+		{
+			name:     "all",
+			expected: []string{"key-a", "key-b", "key-c"},
+		},
+		{
+			name:       "name prefix",
+			namePrefix: lo.ToPtr("ci-"),
+			expected:   []string{"key-a", "key-b"},
+		},
+		{
+			name:     "limit",
+			limit:    1,
+			expected: []string{"key-a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := listAPIKeys(t, c, map[string]tftypes.Value{
+				"name_prefix": tftypes.NewValue(tftypes.String, tt.namePrefix),
+			}, tt.limit)
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("expected %d results, got %d", len(tt.expected), len(results))
+			}
+
+			for i, result := range results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+
+				var organizationID, id types.String
+				result.Identity.GetAttribute(context.Background(), path.Root("organization_id"), &organizationID)
+				result.Identity.GetAttribute(context.Background(), path.Root("id"), &id)
+				if organizationID.ValueString() != orgID || id.ValueString() != tt.expected[i] {
+					t.Errorf("unexpected identity %s/%s", organizationID, id)
+				}
+
+				// the lifetime is included for the key not to be replaced
+				// once imported
+				var expiresIn types.String
+				result.Resource.GetAttribute(context.Background(), path.Root("expires_in"), &expiresIn)
+				if expiresIn.ValueString() != "720h" {
+					t.Errorf("expected the key to expire in 720h, got %s", expiresIn)
+				}
+			}
+		})
+	}
+}
+
+func newListedAPIKey(id, name string) conductor_client.APIKey {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	return conductor_client.APIKey{
+		Data: conductor_client.APIKeyData{
+			Id: lo.ToPtr(id),
+			Attributes: &conductor_client.APIKeyAttributes{
+				Name:      lo.ToPtr(name),
+				Role:      lo.ToPtr(keyRole),
+				CreatedAt: lo.ToPtr(createdAt),
+				ExpiresAt: lo.ToPtr(createdAt.Add(720 * time.Hour)),
+			},
+		},
+	}
+}
+
+// listAPIKeys runs the list resource against the client, including the
+// resource in each result.
+func listAPIKeys(t *testing.T,
+	c catalyst.Client,
+	config map[string]tftypes.Value,
+	limit int64,
+) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	lr := apikey.NewListResource().(list.ListResourceWithConfigure)
+	lr.Configure(ctx, resource.ConfigureRequest{
+		ProviderData: data.ProviderData{Client: c},
+	}, &resource.ConfigureResponse{})
+
+	var listSchema list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchema)
+
+	r := apikey.NewResource().(resource.ResourceWithIdentity)
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: listSchema.Schema,
+			Raw:    tftypes.NewValue(listSchema.Schema.Type().TerraformType(ctx), config),
+		},
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,89 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// toAPIKeyRequest builds the API request creating the key of the model.
+func (m *resourceModel) toAPIKeyRequest(ctx context.Context) (*conductor_client.APIKeyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req := &conductor_client.APIKeyRequest{
+		Name: helpers.StringPointer(m.Name),
+		Role: helpers.StringPointer(m.Role),
+	}
+
+	if !m.Scopes.IsNull() && !m.Scopes.IsUnknown() {
+		var scopes []string
+		diags.Append(m.Scopes.ElementsAs(ctx, &scopes, false)...)
+		req.Scopes = &scopes
+	}
+
+	if !m.ExpiresIn.IsNull() && !m.ExpiresIn.IsUnknown() {
+		duration, err := time.ParseDuration(m.ExpiresIn.ValueString())
+		if err != nil {
+			diags.AddError("Invalid Expiry", err.Error())
+			return nil, diags
+		}
+		req.Duration = lo.ToPtr(int64(duration.Seconds()))
+	}
+
+	return req, diags
+}
+
+// fromAPIKey updates the model with the API object. The token is only ever
+// returned on creation, so it is left untouched when missing.
+func (m *resourceModel) fromAPIKey(ctx context.Context, key *conductor_client.APIKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if key == nil {
+		return diags
+	}
+
+	if key.Data.Id != nil {
+		m.SetID(*key.Data.Id)
+	}
+
+	attributes := lo.FromPtr(key.Data.Attributes)
+	m.Name = helpers.StringFromAPI(m.Name, attributes.Name)
+	m.Role = helpers.StringFromAPI(m.Role, attributes.Role)
+	m.CreatedBy = helpers.StringFromAPI(m.CreatedBy, attributes.CreatedBy)
+	m.CreatedAt = timeFromAPI(attributes.CreatedAt)
+	m.ExpiresAt = timeFromAPI(attributes.ExpiresAt)
+
+	if token := lo.FromPtr(attributes.Token); token != "" {
+		m.SetToken(token)
+	}
+
+	if scopes := lo.FromPtr(attributes.Scopes); len(scopes) > 0 {
+		value, d := types.SetValueFrom(ctx, types.StringType, scopes)
+		diags.Append(d...)
+		m.Scopes = value
+	} else if m.Scopes.IsUnknown() {
+		m.Scopes = types.SetNull(types.StringType)
+	}
+
+	// keys read for the first time, e.g. on import, have no stored token
+	if m.Token.IsUnknown() {
+		m.Token = types.StringNull()
+	}
+
+	return diags
+}
+
+// timeFromAPI formats a timestamp returned by the API, or null when missing.
+func timeFromAPI(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
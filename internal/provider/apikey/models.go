@@ -0,0 +1,104 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceModel describes the resource data model.
+type resourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Role         types.String `tfsdk:"role"`
+	Scopes       types.Set    `tfsdk:"scopes"`
+	ExpiresIn    types.String `tfsdk:"expires_in"`
+	RotateBefore types.String `tfsdk:"rotate_before"`
+	Token        types.String `tfsdk:"token"`
+	CreatedBy    types.String `tfsdk:"created_by"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func NewResourceModel() *resourceModel {
+	return &resourceModel{}
+}
+
+func (m *resourceModel) GetID() string {
+	return m.ID.ValueString()
+}
+
+func (m *resourceModel) SetID(id string) {
+	m.ID = types.StringValue(id)
+}
+
+func (m *resourceModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *resourceModel) SetToken(token string) {
+	m.Token = types.StringValue(token)
+}
+
+// dueForRotation reports whether the key expires within the rotate_before
+// window. Keys without an expiry or a window are never due.
+func (m *resourceModel) dueForRotation(now time.Time) bool {
+	if m.RotateBefore.IsNull() || m.RotateBefore.IsUnknown() ||
+		m.ExpiresAt.IsNull() || m.ExpiresAt.IsUnknown() {
+		return false
+	}
+
+	window, err := time.ParseDuration(m.RotateBefore.ValueString())
+	if err != nil {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !now.Add(window).Before(expiresAt)
+}
+
+// lifetime returns how long the key is valid for after creation, formatted
+// the way it is usually configured, such as "720h", or null when the key
+// never expires.
+func (m *resourceModel) lifetime() types.String {
+	if m.CreatedAt.IsNull() || m.ExpiresAt.IsNull() {
+		return types.StringNull()
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, m.CreatedAt.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil || !expiresAt.After(createdAt) {
+		return types.StringNull()
+	}
+
+	// drop the zero minutes and seconds Duration.String always writes
+	lifetime := expiresAt.Sub(createdAt).String()
+	if strings.HasSuffix(lifetime, "m0s") {
+		lifetime = strings.TrimSuffix(lifetime, "0s")
+	}
+	if strings.HasSuffix(lifetime, "h0m") {
+		lifetime = strings.TrimSuffix(lifetime, "0m")
+	}
+
+	return types.StringValue(lifetime)
+}
+
+func (m *resourceModel) Log(ctx context.Context, msg string) {
+	tflog.Debug(ctx, msg, map[string]interface{}{
+		"id":         m.GetID(),
+		"name":       m.GetName(),
+		"role":       m.Role.ValueString(),
+		"created_by": m.CreatedBy.ValueString(),
+		"created_at": m.CreatedAt.ValueString(),
+		"expires_at": m.ExpiresAt.ValueString(),
+	})
+}
@@ -0,0 +1,437 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &apiKeyResource{}
+var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithIdentity = &apiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &apiKeyResource{}
+
+// apiKeyResource defines the resource implementation.
+type apiKeyResource struct {
+	client   catalyst.Client
+	readOnly bool
+}
+
+func NewResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+func (p *apiKeyResource) Metadata(ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (p *apiKeyResource) Schema(ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalyst organization API key resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "API key identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "API key name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted to the API key, such as `cra.diagrid:editor`. " +
+					"Exactly one of `role` and `scopes` must be set",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("scopes")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the API key. Exactly one of `role` and `scopes` must be set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIfConfigured(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "How long the API key is valid for after creation, such as `720h`. " +
+					"Keys without an expiry never expire",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceIfDurationChanged,
+						"Replace the API key when its lifetime changes",
+						"Replace the API key when its lifetime changes"),
+				},
+			},
+			"rotate_before": schema.StringAttribute{
+				MarkdownDescription: "Replace the API key once it expires within this window, such as `168h`. " +
+					"Rotation happens on the first apply within the window",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("expires_in")),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "API key token, only available when the key is created and null once imported",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "User who created the API key",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the API key was created, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the API key expires, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *apiKeyResource) IdentitySchema(ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema()
+}
+
+func (p *apiKeyResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	p.client = providerData.Client
+	p.readOnly = providerData.ReadOnly
+}
+
+func (p *apiKeyResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// nothing to rotate when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	state := NewResourceModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	plan := NewResourceModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RotateBefore = plan.RotateBefore
+	if !state.dueForRotation(time.Now()) {
+		return
+	}
+
+	tflog.Debug(ctx, "api key due for rotation", map[string]interface{}{
+		"id":            state.GetID(),
+		"expires_at":    state.ExpiresAt.ValueString(),
+		"rotate_before": plan.RotateBefore.ValueString(),
+	})
+
+	// the replacement gets a new identifier, token and timestamps
+	plan.ID = types.StringUnknown()
+	plan.Token = types.StringUnknown()
+	plan.CreatedBy = types.StringUnknown()
+	plan.CreatedAt = types.StringUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// requiresReplaceIfDurationChanged replaces the key when its lifetime
+// changes, but not when the same duration is written another way, as when
+// "720h" is configured for an imported key.
+func requiresReplaceIfDurationChanged(ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	prior, err := time.ParseDuration(req.StateValue.ValueString())
+	if err != nil {
+		resp.RequiresReplace = true
+		return
+	}
+	planned, err := time.ParseDuration(req.PlanValue.ValueString())
+	resp.RequiresReplace = err != nil || planned != prior
+}
+
+func (p *apiKeyResource) Create(ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	if helpers.ReadOnly(p.readOnly, "create", "api key", &resp.Diagnostics) {
+		return
+	}
+
+	model := NewResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyRequest, diags := model.toAPIKeyRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating api key", map[string]interface{}{
+		"name": model.GetName(),
+	})
+
+	key, err := p.client.CreateAPIKey(ctx, keyRequest)
+	if err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, "Error creating api key")
+		return
+	}
+
+	resp.Diagnostics.Append(model.fromAPIKey(ctx, key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Log(ctx, "created api key")
+
+	resp.Diagnostics.Append(setIdentity(ctx, p.client, nil, resp.Identity, model.GetID())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (p *apiKeyResource) Read(ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	model := NewResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := p.client.GetAPIKey(ctx, model.GetID())
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			tflog.Debug(ctx, "api key not found", map[string]interface{}{
+				"id": model.GetID(),
+			})
+
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "error reading api key")
+		return
+	}
+
+	resp.Diagnostics.Append(model.fromAPIKey(ctx, key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Log(ctx, "read api key")
+
+	resp.Diagnostics.Append(setIdentity(ctx, p.client, req.Identity, resp.Identity, model.GetID())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update only stores the plan, since every attribute sent to the API forces
+// a replacement and rotate_before is local to Terraform.
+func (p *apiKeyResource) Update(ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	if helpers.ReadOnly(p.readOnly, "update", "api key", &resp.Diagnostics) {
+		return
+	}
+
+	model := NewResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, p.client, req.Identity, resp.Identity, model.GetID())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (p *apiKeyResource) Delete(ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	if helpers.ReadOnly(p.readOnly, "delete", "api key", &resp.Diagnostics) {
+		return
+	}
+
+	model := NewResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting api key", map[string]interface{}{
+		"id": model.GetID(),
+	})
+
+	if err := p.client.DeleteAPIKey(ctx, model.GetID()); err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			tflog.Debug(ctx, "api key to delete not found", map[string]interface{}{
+				"id": model.GetID(),
+			})
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "Error deleting api key")
+		return
+	}
+}
+
+func (p *apiKeyResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	identity, diags := importIdentity(ctx, p.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := NewResourceModel()
+	model.ID = identity.ID
+	model.Token = types.StringNull()
+	model.Role = types.StringUnknown()
+	model.Scopes = types.SetUnknown(types.StringType)
+
+	key, err := p.client.GetAPIKey(ctx, model.GetID())
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			resp.Diagnostics.AddError("Cannot Import Non-Existent Object",
+				fmt.Sprintf("API key %q does not exist in organization %q",
+					model.GetID(), identity.OrganizationID.ValueString()))
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "error reading imported api key")
+		return
+	}
+
+	resp.Diagnostics.Append(model.fromAPIKey(ctx, key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the lifetime isn't returned, so it is taken from the timestamps for
+	// the configured one not to replace the key
+	model.ExpiresIn = model.lifetime()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
@@ -0,0 +1,421 @@
+package apikey_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/sweep"
+)
+
+var (
+	keyName = acctest.RandomWithPrefix(sweep.APIKeyPrefix)
+	keyRole = "cra.diagrid:editor"
+	orgID   = acctest.RandomWithPrefix("org")
+)
+
+func TestMockAPIKeyResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`+"\n"+`scopes = ["read"]`),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+				{
+					Config:      testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`+"\n"+`expires_in = "-1h"`),
+					ExpectError: regexp.MustCompile(`Invalid Duration`),
+				},
+				// Create and Read testing
+				{
+					Config: testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`+"\n"+`expires_in = "720h"`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("catalyst_api_key.test", "id"),
+						resource.TestCheckResourceAttr("catalyst_api_key.test", "name", keyName),
+						resource.TestCheckResourceAttr("catalyst_api_key.test", "role", keyRole),
+						resource.TestCheckResourceAttrSet("catalyst_api_key.test", "token"),
+						resource.TestCheckResourceAttr("catalyst_api_key.test", "created_by", "user@diagrid.io"),
+						resource.TestCheckResourceAttrSet("catalyst_api_key.test", "created_at"),
+						resource.TestCheckResourceAttrSet("catalyst_api_key.test", "expires_at"),
+						resource.TestCheckNoResourceAttr("catalyst_api_key.test", "scopes"),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:      "catalyst_api_key.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+					// the token is only ever returned on creation and the
+					// rotation window is local to the configuration
+					ImportStateVerifyIgnore: []string{"token", "rotate_before"},
+				},
+				// a key far from expiry is kept
+				{
+					Config: testAccAPIKeyResourceConfig(keyName,
+						`role = "`+keyRole+`"`+"\n"+`expires_in = "720h"`+"\n"+`rotate_before = "24h"`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_api_key.test", plancheck.ResourceActionUpdate),
+						},
+					},
+				},
+				// a key expiring within the window is replaced, and since the
+				// window is longer than its lifetime so is the replacement
+				{
+					Config: testAccAPIKeyResourceConfig(keyName,
+						`role = "`+keyRole+`"`+"\n"+`expires_in = "720h"`+"\n"+`rotate_before = "1000h"`),
+					ExpectNonEmptyPlan: true,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_api_key.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+				},
+				// Delete testing automatically occurs in TestCase
+			},
+		})
+}
+
+func TestMockAPIKeyResourceIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	config := testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`+"\n"+`expires_in = "720h"`)
+
+	// the key is forgotten before being imported again
+	var imported string
+	keyID := func(s *terraform.State) (string, error) {
+		key, ok := s.RootModule().Resources["catalyst_api_key.test"]
+		if !ok {
+			return "", fmt.Errorf("api key not found in state")
+		}
+		imported = key.Primary.ID
+		return key.Primary.ID, nil
+	}
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("catalyst_api_key.test", map[string]knownvalue.Check{
+							"organization_id": knownvalue.StringExact(orgID),
+							"id":              knownvalue.NotNull(),
+						}),
+					},
+				},
+				// import by composite ID
+				{
+					ResourceName: "catalyst_api_key.test",
+					ImportState:  true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) {
+						id, err := keyID(s)
+						return fmt.Sprintf("%s/%s", orgID, id), err
+					},
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"token", "rotate_before"},
+				},
+				// import by identity
+				{
+					ResourceName:    "catalyst_api_key.test",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+				{
+					ResourceName:  "catalyst_api_key.test",
+					ImportState:   true,
+					ImportStateId: "other-org/missing",
+					ExpectError:   regexp.MustCompile(`Organization Mismatch`),
+				},
+				{
+					ResourceName:  "catalyst_api_key.test",
+					ImportState:   true,
+					ImportStateId: "missing",
+					ExpectError:   regexp.MustCompile(`Cannot Import Non-Existent Object`),
+				},
+				// the imported key is kept rather than rotated
+				{
+					Config: `
+removed {
+  from = catalyst_api_key.test
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+				},
+				{
+					Config:       config,
+					ResourceName: "catalyst_api_key.test",
+					ImportState:  true,
+					ImportStateIdFunc: func(*terraform.State) (string, error) {
+						return imported, nil
+					},
+					ImportStatePersist: true,
+				},
+				{
+					Config: config,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestMockAPIKeyResourceScopes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccAPIKeyResourceConfig(keyName, `scopes = ["cra.diagrid:read", "cra.diagrid:write"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_api_key.test", "scopes.#", "2"),
+						resource.TestCheckNoResourceAttr("catalyst_api_key.test", "role"),
+						resource.TestCheckNoResourceAttr("catalyst_api_key.test", "expires_at"),
+					),
+				},
+				{
+					Config:   testAccAPIKeyResourceConfig(keyName, `scopes = ["cra.diagrid:read", "cra.diagrid:write"]`),
+					PlanOnly: true,
+				},
+			},
+		})
+}
+
+func TestMockAPIKeyResourceReadOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	config := func(readOnly bool, rotateBefore string) string {
+		return fmt.Sprintf(`
+provider "catalyst" {
+  read_only = %t
+}
+`, readOnly) + testAccAPIKeyResourceConfig(keyName,
+			`role = "`+keyRole+`"`+"\n"+`expires_in = "720h"`+"\n"+`rotate_before = "`+rotateBefore+`"`)
+	}
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      config(true, "24h"),
+					ExpectError: regexp.MustCompile(`Cannot create api key`),
+				},
+				{
+					Config: config(false, "24h"),
+				},
+				// reads keep working
+				{
+					Config:   config(true, "24h"),
+					PlanOnly: true,
+				},
+				{
+					Config:      config(true, "48h"),
+					ExpectError: regexp.MustCompile(`Cannot update api key`),
+				},
+				{
+					Config:      config(true, "24h"),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Cannot delete api key`),
+				},
+				// lift the mode so the key can be destroyed
+				{
+					Config: config(false, "24h"),
+				},
+			},
+		})
+}
+
+func TestMockAPIKeyResourceReadOnlyEnv(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Setenv("CATALYST_READ_ONLY", "true")
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`),
+					ExpectError: regexp.MustCompile(`Provider Is Read-Only`),
+				},
+			},
+		})
+}
+
+func TestMockAPIKeyResourceReadOnlyEnforced(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Setenv("CATALYST_READ_ONLY", "true")
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				// the configuration can't lift the mode enforced by the
+				// environment
+				{
+					Config: `
+provider "catalyst" {
+  read_only = false
+}
+` + testAccAPIKeyResourceConfig(keyName, `role = "`+keyRole+`"`),
+					ExpectError: regexp.MustCompile(`Cannot create api key`),
+				},
+			},
+		})
+}
+
+func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) func(endpoint, apiKey string) (catalyst.Client, error) {
+	t.Helper()
+
+	var mu sync.Mutex
+	keys := make(map[string]*conductor_client.APIKey)
+
+	return func(endpoint, apiKey string) (catalyst.Client, error) {
+		c := catalyst.NewMockClient(ctrl)
+
+		c.EXPECT().
+			GetUserOrg(gomock.Any()).
+			Return(&conductor_client.Organization{
+				Data: conductor_client.OrganizationData{
+					Id: lo.ToPtr(orgID),
+				},
+			}, nil).
+			AnyTimes()
+
+		c.EXPECT().
+			CreateAPIKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req *conductor_client.APIKeyRequest) (*conductor_client.APIKey, error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				createdAt := time.Now().UTC().Truncate(time.Second)
+				attributes := &conductor_client.APIKeyAttributes{
+					Name:      req.Name,
+					Role:      req.Role,
+					Scopes:    req.Scopes,
+					CreatedBy: lo.ToPtr("user@diagrid.io"),
+					CreatedAt: lo.ToPtr(createdAt),
+				}
+				if req.Duration != nil {
+					attributes.ExpiresAt = lo.ToPtr(createdAt.Add(time.Duration(*req.Duration) * time.Second))
+				}
+
+				id := acctest.RandomWithPrefix("key")
+				keys[id] = &conductor_client.APIKey{
+					Data: conductor_client.APIKeyData{
+						Id:         lo.ToPtr(id),
+						Attributes: attributes,
+					},
+				}
+
+				// the token is only part of the creation response
+				created := *attributes
+				created.Token = lo.ToPtr(acctest.RandomWithPrefix("diagrid"))
+
+				return &conductor_client.APIKey{
+					Data: conductor_client.APIKeyData{
+						Id:         lo.ToPtr(id),
+						Attributes: &created,
+					},
+				}, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			GetAPIKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, id string) (*conductor_client.APIKey, error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				key, ok := keys[id]
+				if !ok {
+					return nil, diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+
+				return key, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			DeleteAPIKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, id string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if _, ok := keys[id]; !ok {
+					return diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+				delete(keys, id)
+
+				return nil
+			}).
+			AnyTimes()
+
+		return c, nil
+	}
+}
+
+func testAccAPIKeyResourceConfig(name, attributes string) string {
+	return fmt.Sprintf(`
+resource "catalyst_api_key" "test" {
+  name = %[1]q
+  %[2]s
+}
+`, name, attributes)
+}
@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string is a positive Go duration, such
+// as "720h".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 720h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration such as `720h`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration",
+			fmt.Sprintf("%q is not a positive duration such as 720h", req.ConfigValue.ValueString()))
+	}
+}
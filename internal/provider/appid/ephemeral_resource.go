@@ -0,0 +1,139 @@
+package appid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/project"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &apiTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &apiTokenEphemeralResource{}
+
+// apiTokenEphemeralResource fetches the API token of an App ID, along with
+// the endpoints of its project, without them being written to state.
+type apiTokenEphemeralResource struct {
+	client catalyst.Client
+}
+
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+func (e *apiTokenEphemeralResource) Metadata(ctx context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_app_id_api_token"
+}
+
+func (e *apiTokenEphemeralResource) Schema(ctx context.Context,
+	req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API token of a Catalyst App ID, used by workloads as `DAPR_API_TOKEN`, " +
+			"together with the endpoints of its project. Nothing is stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Project name",
+				Required:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "App ID name",
+				Required:            true,
+			},
+			"api_token": schema.StringAttribute{
+				MarkdownDescription: "API token of the App ID",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"grpc_endpoint": schema.StringAttribute{
+				MarkdownDescription: "gRPC endpoint of the project",
+				Computed:            true,
+			},
+			"http_endpoint": schema.StringAttribute{
+				MarkdownDescription: "HTTP endpoint of the project",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *apiTokenEphemeralResource) Configure(ctx context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = providerData.Client
+}
+
+func (e *apiTokenEphemeralResource) Open(ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var model apiTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grpcEndpoint, httpEndpoint, err := project.Endpoints(ctx, e.client, model.GetProject())
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			resp.Diagnostics.AddError("Project Not Found",
+				fmt.Sprintf("Project %q does not exist", model.GetProject()))
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "Error reading project")
+		return
+	}
+
+	token, err := e.client.GetAppIDAPIToken(ctx, model.GetProject(), model.GetAppID())
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			resp.Diagnostics.AddError("App ID Not Found",
+				fmt.Sprintf("App ID %q does not exist in project %q", model.GetAppID(), model.GetProject()))
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "Error getting API token")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched app id api token", map[string]interface{}{
+		"project":       model.GetProject(),
+		"app_id":        model.GetAppID(),
+		"grpc_endpoint": grpcEndpoint,
+		"http_endpoint": httpEndpoint,
+	})
+
+	model.SetAPIToken(token)
+	model.SetEndpoints(grpcEndpoint, httpEndpoint)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+}
@@ -0,0 +1,128 @@
+package appid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/samber/lo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+)
+
+var (
+	projectName  = acctest.RandomWithPrefix("prj")
+	appID        = acctest.RandomWithPrefix("app")
+	apiToken     = acctest.RandomWithPrefix("token")
+	grpcEndpoint = fmt.Sprintf("https://grpc-%s.cloud.diagrid.io:443", projectName)
+	httpEndpoint = fmt.Sprintf("https://http-%s.cloud.diagrid.io:443", projectName)
+)
+
+func TestMockAppIDAPITokenEphemeralResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockClientFactory(t, ctrl)),
+				),
+				"echo": echoprovider.NewProviderServer(),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccAPITokenConfig("missing", appID),
+					ExpectError: regexp.MustCompile(`Project Not Found`),
+				},
+				{
+					Config:      testAccAPITokenConfig(projectName, "missing"),
+					ExpectError: regexp.MustCompile(`App ID Not Found`),
+				},
+				{
+					Config: testAccAPITokenConfig(projectName, appID),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("echo.test", "data.api_token", apiToken),
+						resource.TestCheckResourceAttr("echo.test", "data.grpc_endpoint", grpcEndpoint),
+						resource.TestCheckResourceAttr("echo.test", "data.http_endpoint", httpEndpoint),
+					),
+				},
+			},
+		})
+}
+
+func mockClientFactory(t *testing.T, ctrl *gomock.Controller) provider.ClientFactory {
+	return func(endpoint, apiKey string) (catalyst.Client, error) {
+		c := catalyst.NewMockClient(ctrl)
+
+		c.EXPECT().
+			GetProject(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, name string, _ *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+				if name != projectName {
+					return nil, diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+
+				return &cloudruntime_client.Project{
+					Kind: lo.ToPtr(catalyst.KindProject),
+					Metadata: &cloudruntime_client.Metadata{
+						Name: lo.ToPtr(name),
+					},
+					Status: &cloudruntime_client.ProjectStatus{
+						Status: lo.ToPtr("ready"),
+						Endpoints: &cloudruntime_client.ProjectStatusEndpoint{
+							Grpc: &cloudruntime_client.ProjectStatusEndpointDetails{
+								Url: lo.ToPtr(grpcEndpoint),
+							},
+							Http: &cloudruntime_client.ProjectStatusEndpointDetails{
+								Url: lo.ToPtr(httpEndpoint),
+							},
+						},
+					},
+				}, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			GetAppIDAPIToken(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, project, name string) (string, error) {
+				if project != projectName || name != appID {
+					return "", diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+				return apiToken, nil
+			}).
+			AnyTimes()
+
+		return c, nil
+	}
+}
+
+func testAccAPITokenConfig(project, appID string) string {
+	return fmt.Sprintf(`
+ephemeral "catalyst_app_id_api_token" "test" {
+  project = %q
+  app_id  = %q
+}
+
+provider "echo" {
+  data = ephemeral.catalyst_app_id_api_token.test
+}
+
+resource "echo" "test" {}
+`, project, appID)
+}
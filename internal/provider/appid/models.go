@@ -0,0 +1,31 @@
+package appid
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiTokenModel describes the API token ephemeral resource data model.
+type apiTokenModel struct {
+	Project      types.String `tfsdk:"project"`
+	AppID        types.String `tfsdk:"app_id"`
+	APIToken     types.String `tfsdk:"api_token"`
+	GRPCEndpoint types.String `tfsdk:"grpc_endpoint"`
+	HTTPEndpoint types.String `tfsdk:"http_endpoint"`
+}
+
+func (m *apiTokenModel) GetProject() string {
+	return m.Project.ValueString()
+}
+
+func (m *apiTokenModel) GetAppID() string {
+	return m.AppID.ValueString()
+}
+
+func (m *apiTokenModel) SetAPIToken(token string) {
+	m.APIToken = types.StringValue(token)
+}
+
+func (m *apiTokenModel) SetEndpoints(grpcEndpoint, httpEndpoint string) {
+	m.GRPCEndpoint = types.StringValue(grpcEndpoint)
+	m.HTTPEndpoint = types.StringValue(httpEndpoint)
+}
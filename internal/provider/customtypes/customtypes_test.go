@@ -0,0 +1,79 @@
+package customtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+)
+
+func TestURLSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		equal    bool
+	}{
+		{"identical", "https://*.example.com:8443", "https://*.example.com:8443", true},
+		{"case", "HTTPS://*.Example.COM:8443", "https://*.example.com:8443", true},
+		{"default https port", "https://*.example.com:443", "https://*.example.com", true},
+		{"default http port", "http://*.example.com:80", "http://*.example.com", true},
+		{"trailing slash", "https://*.example.com:443/", "https://*.example.com", true},
+		{"trailing dot", "https://*.example.com.:443", "https://*.example.com", true},
+		{"whitespace", " https://*.example.com:443 ", "https://*.example.com", true},
+		{"non default port", "https://*.example.com:8443", "https://*.example.com", false},
+		{"other port for scheme", "http://*.example.com:443", "http://*.example.com", false},
+		{"other host", "https://*.example.com:443", "https://*.example.org:443", false},
+		{"other scheme", "http://*.example.com:8080", "https://*.example.com:8080", false},
+		{"grpc keeps port", "grpc://grpc-prj.example.com:443", "grpc://grpc-prj.example.com", false},
+		{"path", "https://prj.example.com/v1", "https://prj.example.com/v1/", true},
+		{"unparseable", "not a url", "not a url", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := customtypes.NewURLValue(tt.old).
+				StringSemanticEquals(context.Background(), customtypes.NewURLValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.equal {
+				t.Errorf("%q == %q: expected %t, got %t", tt.old, tt.new, tt.equal, equal)
+			}
+		})
+	}
+}
+
+func TestHostSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		equal    bool
+	}{
+		{"identical", "region.example.com", "region.example.com", true},
+		{"case", "Region.Example.COM", "region.example.com", true},
+		{"trailing dot", "region.example.com.", "region.example.com", true},
+		{"whitespace", " region.example.com", "region.example.com", true},
+		{"other host", "region.example.com", "region.example.org", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := customtypes.NewHostValue(tt.old).
+				StringSemanticEquals(context.Background(), customtypes.NewHostValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.equal {
+				t.Errorf("%q == %q: expected %t, got %t", tt.old, tt.new, tt.equal, equal)
+			}
+		})
+	}
+}
+
+func TestURLSemanticEqualsWrongType(t *testing.T) {
+	_, diags := customtypes.NewURLValue("https://example.com").
+		StringSemanticEquals(context.Background(), customtypes.NewHostValue("example.com"))
+	if !diags.HasError() {
+		t.Fatal("expected an error comparing a URL to a host")
+	}
+}
@@ -0,0 +1,109 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = HostType{}
+var _ basetypes.StringValuableWithSemanticEquals = Host{}
+
+// HostType is a string type for host names that the API canonicalizes,
+// such as region hosts.
+type HostType struct {
+	basetypes.StringType
+}
+
+func (t HostType) String() string {
+	return "customtypes.HostType"
+}
+
+func (t HostType) Equal(o attr.Type) bool {
+	other, ok := o.(HostType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HostType) ValueType(ctx context.Context) attr.Value {
+	return Host{}
+}
+
+func (t HostType) ValueFromString(ctx context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return Host{StringValue: in}, nil
+}
+
+func (t HostType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Host is a host name value that is semantically equal to any host name
+// with the same canonical form.
+type Host struct {
+	basetypes.StringValue
+}
+
+func NewHostNull() Host {
+	return Host{StringValue: basetypes.NewStringNull()}
+}
+
+func NewHostUnknown() Host {
+	return Host{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewHostValue(value string) Host {
+	return Host{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Host) Type(ctx context.Context) attr.Type {
+	return HostType{}
+}
+
+func (v Host) Equal(o attr.Value) bool {
+	other, ok := o.(Host)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Host) StringSemanticEquals(ctx context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Host)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	return NormalizeHost(v.ValueString()) == NormalizeHost(newValue.ValueString()), diags
+}
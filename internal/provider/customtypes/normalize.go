@@ -0,0 +1,44 @@
+package customtypes
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// defaultPorts are the ports the API drops from URLs when canonicalizing them.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// NormalizeURL canonicalizes a URL the same way the API does: the scheme
+// and host are lowercased, trailing dots and slashes are dropped and the
+// default port of the scheme is removed. Values that can't be parsed are
+// only trimmed.
+func NormalizeURL(s string) string {
+	s = strings.TrimSpace(s)
+
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := NormalizeHost(u.Hostname())
+	port := u.Port()
+	if port == defaultPorts[scheme] {
+		port = ""
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+
+	return scheme + "://" + host + strings.TrimRight(u.EscapedPath(), "/")
+}
+
+// NormalizeHost canonicalizes a host name the same way the API does: it is
+// lowercased and trailing dots are dropped.
+func NormalizeHost(s string) string {
+	return strings.TrimRight(strings.ToLower(strings.TrimSpace(s)), ".")
+}
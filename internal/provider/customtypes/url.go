@@ -0,0 +1,109 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = URLType{}
+var _ basetypes.StringValuableWithSemanticEquals = URL{}
+
+// URLType is a string type for URLs that the API canonicalizes, such as
+// region ingresses and project endpoints.
+type URLType struct {
+	basetypes.StringType
+}
+
+func (t URLType) String() string {
+	return "customtypes.URLType"
+}
+
+func (t URLType) Equal(o attr.Type) bool {
+	other, ok := o.(URLType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t URLType) ValueType(ctx context.Context) attr.Value {
+	return URL{}
+}
+
+func (t URLType) ValueFromString(ctx context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return URL{StringValue: in}, nil
+}
+
+func (t URLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// URL is a URL value that is semantically equal to any URL with the same
+// canonical form.
+type URL struct {
+	basetypes.StringValue
+}
+
+func NewURLNull() URL {
+	return URL{StringValue: basetypes.NewStringNull()}
+}
+
+func NewURLUnknown() URL {
+	return URL{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewURLValue(value string) URL {
+	return URL{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v URL) Type(ctx context.Context) attr.Type {
+	return URLType{}
+}
+
+func (v URL) Equal(o attr.Value) bool {
+	other, ok := o.(URL)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v URL) StringSemanticEquals(ctx context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(URL)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	return NormalizeURL(v.ValueString()) == NormalizeURL(newValue.ValueString()), diags
+}
@@ -1,7 +1,21 @@
 package data
 
-import "github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+import (
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
+)
 
 type ProviderData struct {
 	Client catalyst.Client
+
+	// ReadOnly denies every create, update and delete of resources.
+	ReadOnly bool
+
+	// Projects and Regions poll the objects resources wait on, shared by
+	// every resource of the provider. Resources get their objects directly
+	// when nil.
+	Projects *poller.Poller[cloudruntime_client.Project]
+	Regions  *poller.Poller[cloudruntime_client.Region]
 }
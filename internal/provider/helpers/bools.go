@@ -0,0 +1,16 @@
+package helpers
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// BoolWithDefault returns the value, or the default when the value is null
+// or unknown, such as an attribute missing from state written before it
+// was introduced.
+func BoolWithDefault(v basetypes.BoolValue, def bool) basetypes.BoolValue {
+	if v.IsNull() || v.IsUnknown() {
+		return basetypes.NewBoolValue(def)
+	}
+
+	return v
+}
@@ -2,34 +2,32 @@ package helpers
 
 import (
 	"context"
-	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-func WaitUntil(ctx context.Context, fn func(context.Context) (bool, error)) error {
-	// immediately check the condition
-	done, err := fn(ctx)
-	if err != nil {
-		return err
-	}
-	if done {
-		return nil
+// SetPartialState stores the model in state with any unknown values nulled
+// out, so an object that was created but never became ready is still
+// tracked by Terraform.
+func SetPartialState(ctx context.Context, state *tfsdk.State, model any) diag.Diagnostics {
+	diags := state.Set(ctx, model)
+	if diags.HasError() {
+		return diags
 	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		// check every 5s
-		case <-time.After(2 * time.Second):
-			done, err := fn(ctx)
-			if err != nil {
-				return err
-			}
-			if done {
-				return nil
-			}
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
 		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Error Saving Partial State", err.Error())
+		return diags
 	}
+	state.Raw = raw
 
-	return nil
+	return diags
 }
@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+)
+
+// Identity is the identity shared by Catalyst resources: the organization
+// the object lives in and its name within that organization.
+type Identity struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+}
+
+// IdentitySchema returns the identity schema for a Catalyst resource.
+func IdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_id": identityschema.StringAttribute{
+				Description:       "Identifier of the organization the object belongs to",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the object",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// OrganizationID returns the identifier of the organization the API key
+// belongs to.
+func OrganizationID(ctx context.Context, client catalyst.Client) (string, error) {
+	org, err := client.GetUserOrg(ctx)
+	if err != nil {
+		return "", err
+	}
+	if org == nil || org.Data.Id == nil {
+		return "", fmt.Errorf("organization has no identifier")
+	}
+
+	return *org.Data.Id, nil
+}
+
+// SetIdentity stores the identity of the named object in the response
+// identity. The organization is carried over from the prior identity when
+// known, and only looked up otherwise. Nothing is stored when Terraform
+// doesn't support resource identity.
+func SetIdentity(ctx context.Context,
+	client catalyst.Client,
+	prior *tfsdk.ResourceIdentity,
+	identity *tfsdk.ResourceIdentity,
+	name string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	var model Identity
+	if prior != nil && !prior.Raw.IsNull() {
+		diags.Append(prior.Get(ctx, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if model.OrganizationID.IsNull() || model.OrganizationID.IsUnknown() {
+		orgID, err := OrganizationID(ctx, client)
+		if err != nil {
+			apierrors.AddError(&diags, err, "Error getting organization")
+			return diags
+		}
+		model.OrganizationID = types.StringValue(orgID)
+	}
+	model.Name = types.StringValue(name)
+
+	diags.Append(identity.Set(ctx, model)...)
+	return diags
+}
+
+// ImportIdentity resolves the object being imported, either from an import
+// ID of the form `<organization>/<name>` or `<name>`, or from the identity
+// in an import block. The organization, when given, must be the one the API
+// key belongs to.
+func ImportIdentity(ctx context.Context,
+	client catalyst.Client,
+	req resource.ImportStateRequest,
+) (Identity, diag.Diagnostics) {
+	var (
+		model Identity
+		diags diag.Diagnostics
+	)
+
+	if req.ID != "" {
+		orgID, name, found := strings.Cut(req.ID, "/")
+		if !found {
+			orgID, name = "", req.ID
+		}
+		if name == "" || strings.Contains(name, "/") {
+			diags.AddError("Invalid Import ID",
+				fmt.Sprintf("Expected an import ID of the form <name> or <organization>/<name>, got %q", req.ID))
+			return model, diags
+		}
+
+		model.Name = types.StringValue(name)
+		if orgID != "" {
+			model.OrganizationID = types.StringValue(orgID)
+		}
+	} else if req.Identity != nil {
+		diags.Append(req.Identity.Get(ctx, &model)...)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	if model.Name.ValueString() == "" {
+		diags.AddError("Invalid Import Identity",
+			"The name of the object to import must be set")
+		return model, diags
+	}
+
+	orgID, err := OrganizationID(ctx, client)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error getting organization")
+		return model, diags
+	}
+
+	if given := model.OrganizationID.ValueString(); given != "" && given != orgID {
+		diags.AddError("Organization Mismatch",
+			fmt.Sprintf("Cannot import %q from organization %q, the configured API key belongs to organization %q",
+				model.Name.ValueString(), given, orgID))
+		return model, diags
+	}
+	model.OrganizationID = types.StringValue(orgID)
+
+	return model, diags
+}
@@ -0,0 +1,156 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultPoller is the poller WaitFor uses.
+var DefaultPoller = Poller{
+	InitialInterval:    time.Second,
+	MaxInterval:        30 * time.Second,
+	Multiplier:         1.5,
+	Jitter:             0.2,
+	MaxTransientErrors: 3,
+	ProgressInterval:   30 * time.Second,
+}
+
+// PollFunc checks a condition, returning whether it holds and the current
+// status of the polled object, which is reported in progress logs.
+type PollFunc func(ctx context.Context) (done bool, status string, err error)
+
+// Poller polls a condition until it holds, backing off exponentially between
+// polls so that waiting on many objects doesn't hammer the API. Customized
+// pollers should start from a copy of DefaultPoller.
+type Poller struct {
+	// InitialInterval is the delay between the first two polls.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between polls.
+	MaxInterval time.Duration
+	// Multiplier grows the delay after every poll.
+	Multiplier float64
+	// Jitter randomizes every delay by up to this fraction of it, so objects
+	// waited for together don't poll in lockstep.
+	Jitter float64
+	// MaxTransientErrors is the number of consecutive failed polls
+	// tolerated before giving up. Errors marked with Permanent are never
+	// tolerated.
+	MaxTransientErrors int
+	// ProgressInterval is how often progress is logged while waiting.
+	ProgressInterval time.Duration
+}
+
+// WaitFor polls fn with the DefaultPoller until it is done, fails, or the
+// context ends. description names what is waited for in logs and errors,
+// such as "project my-project to be ready".
+func WaitFor(ctx context.Context, description string, fn PollFunc) error {
+	return DefaultPoller.Poll(ctx, description, fn)
+}
+
+// Poll polls fn until it is done, returns a permanent error or more
+// consecutive errors than tolerated, or the context ends.
+func (p Poller) Poll(ctx context.Context, description string, fn PollFunc) error {
+	start := time.Now()
+	lastProgress := start
+	interval := p.InitialInterval
+
+	var (
+		status string
+		errs   int
+	)
+	for polls := 1; ; polls++ {
+		done, s, err := fn(ctx)
+		switch {
+		case err == nil:
+			errs = 0
+			status = s
+			if done {
+				tflog.Debug(ctx, "done waiting for "+description,
+					map[string]interface{}{
+						"elapsed": time.Since(start).Round(time.Second).String(),
+						"polls":   polls,
+					})
+				return nil
+			}
+		case ctx.Err() != nil:
+			return p.timeout(ctx, description, start, status)
+		case isPermanent(err) || errs >= p.MaxTransientErrors:
+			return err
+		default:
+			errs++
+			tflog.Warn(ctx, "error polling, retrying",
+				map[string]interface{}{
+					"waiting_for": description,
+					"error":       err.Error(),
+					"errors":      errs,
+				})
+		}
+
+		if time.Since(lastProgress) >= p.ProgressInterval {
+			lastProgress = time.Now()
+			tflog.Info(ctx, "still waiting for "+description,
+				map[string]interface{}{
+					"elapsed": time.Since(start).Round(time.Second).String(),
+					"status":  status,
+				})
+		}
+
+		timer := time.NewTimer(p.jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return p.timeout(ctx, description, start, status)
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*p.Multiplier), p.MaxInterval)
+	}
+}
+
+// jitter randomizes the interval by up to Jitter of it in either direction.
+func (p Poller) jitter(interval time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return interval
+	}
+
+	return time.Duration(float64(interval) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
+
+// timeout returns the error of a context that ended while polling.
+func (p Poller) timeout(ctx context.Context, description string, start time.Time, status string) error {
+	elapsed := time.Since(start).Round(time.Second)
+	if status == "" {
+		return fmt.Errorf("gave up waiting for %s after %s: %w", description, elapsed, ctx.Err())
+	}
+
+	return fmt.Errorf("gave up waiting for %s after %s, last status %q: %w", description, elapsed, status, ctx.Err())
+}
+
+// permanentError is an error polling doesn't retry.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks an error returned by a PollFunc as not worth retrying, so
+// polling fails on it immediately.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+func isPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
@@ -0,0 +1,143 @@
+package helpers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+func testPoller() helpers.Poller {
+	poller := helpers.DefaultPoller
+	poller.InitialInterval = 10 * time.Millisecond
+	poller.MaxInterval = 40 * time.Millisecond
+	poller.Multiplier = 2
+	poller.Jitter = 0
+	poller.MaxTransientErrors = 2
+
+	return poller
+}
+
+func TestPollBacksOff(t *testing.T) {
+	var polls []time.Time
+	err := testPoller().Poll(context.Background(), "test", func(context.Context) (bool, string, error) {
+		polls = append(polls, time.Now())
+		return len(polls) == 6, "processing", nil
+	})
+	if err != nil {
+		t.Fatalf("polling: %s", err)
+	}
+
+	for i, expected := range []time.Duration{10, 20, 40, 40, 40} {
+		expected *= time.Millisecond
+		if actual := polls[i+1].Sub(polls[i]); actual < expected || actual > expected+30*time.Millisecond {
+			t.Errorf("expected poll %d to wait %s, waited %s", i+2, expected, actual)
+		}
+	}
+}
+
+func TestPollTransientErrors(t *testing.T) {
+	transient := errors.New("bad gateway")
+
+	// errors are tolerated as long as successful polls reset their count
+	results := []error{transient, transient, nil, transient, transient, nil}
+	polls := 0
+	err := testPoller().Poll(context.Background(), "test", func(context.Context) (bool, string, error) {
+		err := results[polls]
+		polls++
+		return polls == len(results), "processing", err
+	})
+	if err != nil {
+		t.Errorf("expected transient errors to be tolerated, got %s", err)
+	}
+
+	polls = 0
+	err = testPoller().Poll(context.Background(), "test", func(context.Context) (bool, string, error) {
+		polls++
+		return false, "", transient
+	})
+	if !errors.Is(err, transient) {
+		t.Errorf("expected the last error once too many failed in a row, got %v", err)
+	}
+	if polls != 3 {
+		t.Errorf("expected 3 polls, got %d", polls)
+	}
+}
+
+func TestPollPermanentError(t *testing.T) {
+	permanent := errors.New("forbidden")
+
+	polls := 0
+	err := testPoller().Poll(context.Background(), "test", func(context.Context) (bool, string, error) {
+		polls++
+		return false, "", helpers.Permanent(permanent)
+	})
+	if !errors.Is(err, permanent) {
+		t.Errorf("expected the permanent error, got %v", err)
+	}
+	if polls != 1 {
+		t.Errorf("expected permanent errors not to be retried, got %d polls", polls)
+	}
+}
+
+func TestPollDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := testPoller().Poll(ctx, "project test to be ready", func(context.Context) (bool, string, error) {
+		return false, "processing", nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `project test to be ready`) ||
+		!strings.Contains(err.Error(), `last status "processing"`) {
+		t.Errorf("expected the error to describe the wait, got %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("expected polling to stop at the deadline, took %s", elapsed)
+	}
+}
+
+func TestPollProgress(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	poller := testPoller()
+	poller.ProgressInterval = 30 * time.Millisecond
+
+	polls := 0
+	err := poller.Poll(ctx, "project test to be ready", func(context.Context) (bool, string, error) {
+		polls++
+		return polls == 5, "processing", nil
+	})
+	if err != nil {
+		t.Fatalf("polling: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decoding logs: %s", err)
+	}
+
+	progress := 0
+	for _, entry := range entries {
+		if entry["@message"] != "still waiting for project test to be ready" {
+			continue
+		}
+		progress++
+		if entry["status"] != "processing" || entry["elapsed"] == nil {
+			t.Errorf("expected progress with the elapsed time and status, got %v", entry)
+		}
+	}
+	if progress == 0 {
+		t.Errorf("expected progress logs, got %v", entries)
+	}
+}
@@ -0,0 +1,23 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ReadOnly adds an error to the diagnostics when the provider is configured
+// in read-only mode, so mutations stop before any API call is made. It
+// returns whether the operation has been denied.
+func ReadOnly(readOnly bool, operation, kind string, diags *diag.Diagnostics) bool {
+	if !readOnly {
+		return false
+	}
+
+	diags.AddError("Provider Is Read-Only",
+		fmt.Sprintf("Cannot %s %s: the provider is configured with read_only = true, "+
+			"either in its configuration or through the CATALYST_READ_ONLY environment variable.",
+			operation, kind))
+
+	return true
+}
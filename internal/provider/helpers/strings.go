@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/samber/lo"
+)
+
+// StringPointer returns a pointer to the string, or nil when the value is
+// null or unknown so it is left out of API requests instead of being sent
+// as an empty string.
+func StringPointer(v basetypes.StringValue) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return lo.ToPtr(v.ValueString())
+}
+
+// StringFromAPI converts a string returned by the API into a value. The API
+// doesn't tell unset and empty strings apart, so either comes back as an
+// empty string when the prior value was empty and as null otherwise.
+func StringFromAPI(prior basetypes.StringValue, v *string) basetypes.StringValue {
+	if v == nil || *v == "" {
+		if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+			return prior
+		}
+		return basetypes.NewStringNull()
+	}
+
+	return basetypes.NewStringValue(*v)
+}
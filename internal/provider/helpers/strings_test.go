@@ -0,0 +1,73 @@
+package helpers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+var (
+	// values an optional string can have in a plan
+	planned = map[string]types.String{
+		"null":    types.StringNull(),
+		"unknown": types.StringUnknown(),
+		"empty":   types.StringValue(""),
+		"value":   types.StringValue("value"),
+	}
+
+	// what should be sent to the API for each planned value
+	requested = map[string]*string{
+		"null":    nil,
+		"unknown": nil,
+		"empty":   lo.ToPtr(""),
+		"value":   lo.ToPtr("value"),
+	}
+
+	// what should be stored in state once the API returned the value
+	stored = map[string]types.String{
+		"null":    types.StringNull(),
+		"unknown": types.StringNull(),
+		"empty":   types.StringValue(""),
+		"value":   types.StringValue("value"),
+	}
+
+	// the ways the API returns what it was sent
+	responses = map[string]func(*string) *string{
+		"echoed": func(s *string) *string { return s },
+		"omitted": func(s *string) *string {
+			if s == nil || *s == "" {
+				return nil
+			}
+			return s
+		},
+		"emptied": func(s *string) *string {
+			if s == nil {
+				return lo.ToPtr("")
+			}
+			return s
+		},
+	}
+)
+
+func TestStringRoundTrip(t *testing.T) {
+	for plannedCase, value := range planned {
+		for responseCase, respond := range responses {
+			t.Run(fmt.Sprintf("%s/%s", plannedCase, responseCase), func(t *testing.T) {
+				sent := helpers.StringPointer(value)
+				if (requested[plannedCase] == nil) != (sent == nil) ||
+					(sent != nil && *sent != *requested[plannedCase]) {
+					t.Fatalf("expected %v to be sent, got %v", lo.FromPtr(requested[plannedCase]), lo.FromPtr(sent))
+				}
+
+				// the API responds with what it stored
+				if v := helpers.StringFromAPI(value, respond(sent)); !v.Equal(stored[plannedCase]) {
+					t.Errorf("expected %s to be stored, got %s", stored[plannedCase], v)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout bounds operations waiting on the API when the
+// configuration sets no timeout.
+const DefaultTimeout = 20 * time.Minute
+
+// TimeoutsBlock is the timeouts block of resources waiting on the API to
+// create, update or delete objects.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// NullTimeouts is the value of a timeouts block left out of the
+// configuration.
+func NullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// WithTimeout bounds the context by the configured timeout of the
+// operation, such as timeouts.Value.Create, or DefaultTimeout.
+func WithTimeout(ctx context.Context,
+	timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	d, diags := timeout(ctx, DefaultTimeout)
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, diags
+}
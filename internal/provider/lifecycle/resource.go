@@ -0,0 +1,489 @@
+// Package lifecycle implements the create, read, update, delete and import
+// operations shared by resources managing named Catalyst API objects that
+// become ready asynchronously. Resources describe their object with a
+// Definition and embed the Resource built from it, only implementing their
+// schema and any plan logic themselves.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
+)
+
+// Model is the Terraform model of a resource managed by Resource.
+type Model interface {
+	fmt.Stringer
+
+	GetName() string
+	SetName(name string)
+	GetDeletionProtection() bool
+	GetTimeouts() timeouts.Value
+}
+
+// Definition describes the API object a resource manages and how it maps to
+// the resource model.
+type Definition[M Model, O any] struct {
+	// Kind names the object in logs and diagnostics, such as "project".
+	Kind string
+
+	// NewModel returns an empty model.
+	NewModel func() M
+	// FromAPI updates the model with the API object.
+	FromAPI func(m M, o *O)
+	// ImportDefaults sets the resource only attributes of an imported
+	// model, which can't be read from the API.
+	ImportDefaults func(m M)
+
+	// Get returns the named object, or a not found error.
+	Get func(ctx context.Context, client catalyst.Client, name string) (*O, error)
+	// Create creates the object of the planned model, and may update the
+	// model with values only returned on creation.
+	Create func(ctx context.Context, client catalyst.Client, m M) error
+	// Update updates the existing object to match the planned model.
+	Update func(ctx context.Context, client catalyst.Client, m M, existing *O) error
+	// BeforeDelete checks whether the object can be deleted, and cleans up
+	// what must go before it does. Optional.
+	BeforeDelete func(ctx context.Context, client catalyst.Client, m M) diag.Diagnostics
+	// Delete starts deleting the object.
+	Delete func(ctx context.Context, client catalyst.Client, m M) error
+	// Ready reports whether a created or updated object reached the state
+	// the model waits for.
+	Ready func(m M, o *O) bool
+	// Status returns the status of the object reported while waiting for
+	// it. Optional.
+	Status func(o *O) string
+	// Attributes maps the fields of the object, such as "spec.region", to
+	// the attributes they are set from, so the API rejecting a field is
+	// reported on its attribute. Optional.
+	Attributes map[string]path.Path
+	// Poller returns the poller shared by the resources waiting on objects
+	// of the kind, which is used instead of Get while waiting. Optional.
+	Poller func(providerData data.ProviderData) *poller.Poller[O]
+}
+
+// Resource implements the lifecycle of the objects of a Definition.
+type Resource[M Model, O any] struct {
+	def Definition[M, O]
+
+	client   catalyst.Client
+	readOnly bool
+	poller   *poller.Poller[O]
+}
+
+// New returns a resource managing the objects of the definition.
+func New[M Model, O any](def Definition[M, O]) *Resource[M, O] {
+	return &Resource[M, O]{def: def}
+}
+
+// Client returns the client of the configured provider, nil until then.
+func (r *Resource[M, O]) Client() catalyst.Client {
+	return r.client
+}
+
+func (r *Resource[M, O]) IdentitySchema(ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = helpers.IdentitySchema()
+}
+
+func (r *Resource[M, O]) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.readOnly = providerData.ReadOnly
+	if r.def.Poller != nil {
+		r.poller = r.def.Poller(providerData)
+	}
+}
+
+func (r *Resource[M, O]) Create(ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	if helpers.ReadOnly(r.readOnly, "create", r.def.Kind, &resp.Diagnostics) {
+		return
+	}
+
+	model := r.def.NewModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := helpers.WithTimeout(ctx, model.GetTimeouts().Create)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating "+r.def.Kind,
+		map[string]interface{}{
+			"model": model.String(),
+		})
+
+	if err := r.def.Create(ctx, r.client, model); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error creating %s", r.def.Kind),
+			apierrors.WithAttributes(r.def.Attributes))
+		return
+	}
+
+	// save what we know so far, so a failed wait taints the object
+	// instead of leaving it unknown to Terraform
+	resp.Diagnostics.Append(helpers.SetPartialState(ctx, &resp.State, model)...)
+	resp.Diagnostics.Append(helpers.SetIdentity(ctx, r.client, nil, resp.Identity, model.GetName())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.waitUntilReady(ctx, model); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error getting %s", r.def.Kind))
+		return
+	}
+
+	tflog.Debug(ctx, "created "+r.def.Kind,
+		map[string]interface{}{
+			"name": model.GetName(),
+		})
+
+	if err := r.read(ctx, model); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("error reading created %s", r.def.Kind))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *Resource[M, O]) Read(ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	model := r.def.NewModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, model); err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			tflog.Debug(ctx, r.def.Kind+" not found",
+				map[string]interface{}{
+					"name": model.GetName(),
+				})
+
+			// objects left tainted by a failed create have no identity yet,
+			// which the framework requires even when removing them
+			resp.Diagnostics.Append(helpers.SetIdentity(ctx, r.client, req.Identity, resp.Identity, model.GetName())...)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("error reading %s", r.def.Kind))
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.SetIdentity(ctx, r.client, req.Identity, resp.Identity, model.GetName())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *Resource[M, O]) Update(ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	if helpers.ReadOnly(r.readOnly, "update", r.def.Kind, &resp.Diagnostics) {
+		return
+	}
+
+	model := r.def.NewModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := helpers.WithTimeout(ctx, model.GetTimeouts().Update)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.def.Get(ctx, r.client, model.GetName())
+	if err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error getting %s", r.def.Kind))
+		return
+	}
+
+	tflog.Debug(ctx, "updating "+r.def.Kind,
+		map[string]interface{}{
+			"model": model.String(),
+		})
+
+	if err := r.def.Update(ctx, r.client, model, existing); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error updating %s", r.def.Kind),
+			apierrors.WithAttributes(r.def.Attributes))
+		return
+	}
+
+	if err := r.waitUntilReady(ctx, model); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error getting %s", r.def.Kind))
+		return
+	}
+
+	if err := r.read(ctx, model); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("error reading updated %s", r.def.Kind))
+		return
+	}
+
+	tflog.Debug(ctx, "updated "+r.def.Kind,
+		map[string]interface{}{
+			"model": model.String(),
+		})
+
+	resp.Diagnostics.Append(helpers.SetIdentity(ctx, r.client, req.Identity, resp.Identity, model.GetName())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *Resource[M, O]) Delete(ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	if helpers.ReadOnly(r.readOnly, "delete", r.def.Kind, &resp.Diagnostics) {
+		return
+	}
+
+	model := r.def.NewModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := helpers.WithTimeout(ctx, model.GetTimeouts().Delete)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.GetDeletionProtection() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			fmt.Sprintf("%s %q has deletion_protection set. "+
+				"Set it to false and apply before destroying the %s.", r.title(), model.GetName(), r.def.Kind))
+		return
+	}
+
+	if r.def.BeforeDelete != nil {
+		resp.Diagnostics.Append(r.def.BeforeDelete(ctx, r.client, model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting "+r.def.Kind,
+		map[string]interface{}{
+			"name": model.GetName(),
+		})
+
+	if err := r.def.Delete(ctx, r.client, model); err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			tflog.Debug(ctx, r.def.Kind+" to delete not found",
+				map[string]interface{}{
+					"name": model.GetName(),
+				})
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error deleting %s", r.def.Kind))
+		return
+	}
+
+	// wait until the object is gone
+	if err := r.wait(ctx, r.describe(model, "deleted"), func(ctx context.Context) (bool, string, error) {
+		o, err := r.poll(ctx, model.GetName())
+		if err != nil {
+			if diagrid_errors.IsResourceNotFoundError(err) {
+				return true, "", nil
+			}
+
+			return false, "", fmt.Errorf("error checking for deleted %s: %w", r.def.Kind, err)
+		}
+
+		return false, r.status(o), nil
+	}); err != nil {
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("Error getting %s", r.def.Kind))
+		return
+	}
+
+	tflog.Debug(ctx, "deleted "+r.def.Kind,
+		map[string]interface{}{
+			"name": model.GetName(),
+		})
+}
+
+func (r *Resource[M, O]) ImportState(ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	identity, diags := helpers.ImportIdentity(ctx, r.client, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := r.def.NewModel()
+	model.SetName(identity.Name.ValueString())
+	if r.def.ImportDefaults != nil {
+		r.def.ImportDefaults(model)
+	}
+
+	if err := r.read(ctx, model); err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			resp.Diagnostics.AddError("Cannot Import Non-Existent Object",
+				fmt.Sprintf("%s %q does not exist in organization %q",
+					r.title(), model.GetName(), identity.OrganizationID.ValueString()))
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, fmt.Sprintf("error reading imported %s", r.def.Kind))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+// read updates the model with the object it names.
+func (r *Resource[M, O]) read(ctx context.Context, model M) error {
+	tflog.Debug(ctx, "reading "+r.def.Kind,
+		map[string]interface{}{
+			"name": model.GetName(),
+		})
+
+	o, err := r.def.Get(ctx, r.client, model.GetName())
+	if err != nil {
+		return err
+	}
+
+	r.def.FromAPI(model, o)
+
+	tflog.Debug(ctx, "read "+r.def.Kind,
+		map[string]interface{}{
+			"model": model.String(),
+		})
+
+	return nil
+}
+
+// waitUntilReady waits until the created or updated object is ready.
+func (r *Resource[M, O]) waitUntilReady(ctx context.Context, model M) error {
+	return r.wait(ctx, r.describe(model, "ready"), func(ctx context.Context) (bool, string, error) {
+		o, err := r.poll(ctx, model.GetName())
+		if err != nil {
+			return false, "", fmt.Errorf("Error getting %s: %w", r.def.Kind, err)
+		}
+
+		if r.def.Ready(model, o) {
+			return true, r.status(o), nil
+		}
+
+		tflog.Debug(ctx, r.def.Kind+" not ready yet",
+			map[string]interface{}{
+				"name":   model.GetName(),
+				"status": r.status(o),
+			})
+
+		return false, r.status(o), nil
+	})
+}
+
+// wait polls fn until the object is done waiting for, pacing the polls
+// with the shared poller when there is one.
+func (r *Resource[M, O]) wait(ctx context.Context, description string, fn helpers.PollFunc) error {
+	if r.poller != nil {
+		return poller.Waiter().Poll(ctx, description, fn)
+	}
+
+	return helpers.WaitFor(ctx, description, fn)
+}
+
+// poll gets the object waited on, from the shared poller when there is
+// one.
+func (r *Resource[M, O]) poll(ctx context.Context, name string) (*O, error) {
+	if r.poller != nil {
+		return r.poller.Get(ctx, name)
+	}
+
+	return r.def.Get(catalyst.Fresh(ctx), r.client, name)
+}
+
+// describe names what is waited for on the object in logs and errors.
+func (r *Resource[M, O]) describe(model M, state string) string {
+	return fmt.Sprintf("%s %s to be %s", r.def.Kind, model.GetName(), state)
+}
+
+// status returns the status of the object, if the definition reports one.
+func (r *Resource[M, O]) status(o *O) string {
+	if r.def.Status == nil {
+		return ""
+	}
+
+	return r.def.Status(o)
+}
+
+// title returns the kind capitalized, to start diagnostics with.
+func (r *Resource[M, O]) title() string {
+	return strings.ToUpper(r.def.Kind[:1]) + r.def.Kind[1:]
+}
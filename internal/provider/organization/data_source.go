@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 )
 
@@ -93,23 +94,18 @@ func (d *dataSource) Read(ctx context.Context,
 	// Read the user's current organization data
 	org, err := d.client.GetUserOrg(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read organization data", err.Error())
+		apierrors.AddError(&resp.Diagnostics, err, "Failed to read organization data")
 		return
 	}
 
+	// Save the organization data into the model
+	model.fromOrganization(org)
+
 	tflog.Debug(ctx, "read organization data", map[string]interface{}{
-		"id":   *org.Data.Id,
-		"name": *org.Data.Attributes.Name,
+		"id":   model.ID.ValueString(),
+		"name": model.Name.ValueString(),
 	})
 
-	// Save the organization data into the model
-	model.SetID(*org.Data.Id)
-	model.SetName(*org.Data.Attributes.Name)
-	if org.Data.Attributes.Products != nil &&
-		org.Data.Attributes.Products.Cra != nil {
-		model.SetPlan(*org.Data.Attributes.Products.Cra.Plan)
-	}
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"github.com/samber/lo"
+
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+)
+
+// fromOrganization updates the model with the API object. Any part of the
+// object may be missing, in which case the attributes it holds are left null.
+func (m *model) fromOrganization(org *conductor_client.Organization) {
+	if org == nil {
+		return
+	}
+
+	if org.Data.Id != nil {
+		m.SetID(*org.Data.Id)
+	}
+
+	attributes := org.Data.Attributes
+	if attributes == nil {
+		return
+	}
+
+	if attributes.Name != nil {
+		m.SetName(*attributes.Name)
+	}
+	if attributes.Products != nil &&
+		attributes.Products.Cra != nil &&
+		attributes.Products.Cra.Plan != nil {
+		m.SetPlan(lo.FromPtr(attributes.Products.Cra.Plan))
+	}
+}
@@ -0,0 +1,30 @@
+package organization
+
+import (
+	"encoding/json"
+	"testing"
+
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+)
+
+func FuzzOrganizationFromAPI(f *testing.F) {
+	f.Add([]byte(`null`))
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"data":{"attributes":{}}}`))
+	f.Add([]byte(`{"data":{"attributes":{"products":{"cra":{}}}}}`))
+	f.Add([]byte(`{"data":{"id":"org","attributes":{"name":"org","products":{"cra":{"plan":"free"}}}}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var org *conductor_client.Organization
+		if err := json.Unmarshal(data, &org); err != nil {
+			t.Skip()
+		}
+
+		m := NewModel()
+		m.fromOrganization(org)
+
+		if m.ID.IsUnknown() || m.Name.IsUnknown() || m.Plan.IsUnknown() {
+			t.Errorf("expected known values after mapping, got %+v", m)
+		}
+	})
+}
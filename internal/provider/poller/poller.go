@@ -0,0 +1,201 @@
+// Package poller shares the polling of API objects between the resources
+// waiting on them. Rather than every resource getting its own object on
+// every poll, a Poller lists every object of a kind once per tick and hands
+// each waiter the object it waits on, so the API traffic of an apply stays
+// constant however many objects it waits on in parallel.
+package poller
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+const (
+	// DefaultInterval is the time between the list calls of a poller.
+	DefaultInterval = 2 * time.Second
+
+	// listTimeout bounds every list call.
+	listTimeout = time.Minute
+)
+
+// Waiter returns the helpers.Poller to wait with on objects got from a
+// Poller. It polls again as soon as a get returns without backing off, as
+// every get already waits for the next tick.
+func Waiter() helpers.Poller {
+	return helpers.Poller{
+		InitialInterval:    0,
+		MaxInterval:        0,
+		Multiplier:         1,
+		Jitter:             0,
+		MaxTransientErrors: helpers.DefaultPoller.MaxTransientErrors,
+		ProgressInterval:   helpers.DefaultPoller.ProgressInterval,
+	}
+}
+
+// Poller lists the objects of a kind on behalf of every waiter.
+type Poller[O any] struct {
+	kind     string
+	interval time.Duration
+	list     func(ctx context.Context) ([]O, error)
+	name     func(o *O) string
+	// get and complete refresh the objects listed without everything
+	// waiters need, see WithRefresh.
+	get      func(ctx context.Context, name string) (*O, error)
+	complete func(o *O) bool
+
+	mu sync.Mutex
+	// waiters are the results awaited on the next tick, by object name.
+	waiters map[string][]chan result[O]
+	running bool
+}
+
+// result is the object, or error, a waiter got on a tick.
+type result[O any] struct {
+	o   *O
+	err error
+}
+
+// New returns a poller listing the objects of the kind with list, which are
+// told apart by name.
+func New[O any](kind string,
+	list func(ctx context.Context) ([]O, error),
+	name func(o *O) string,
+) *Poller[O] {
+	return &Poller[O]{
+		kind:     kind,
+		interval: DefaultInterval,
+		list:     list,
+		name:     name,
+		waiters:  make(map[string][]chan result[O]),
+	}
+}
+
+// WithInterval sets the time between list calls.
+func (p *Poller[O]) WithInterval(interval time.Duration) *Poller[O] {
+	p.interval = interval
+	return p
+}
+
+// WithRefresh gets the waited on objects complete doesn't find complete
+// when listed, such as objects listed without their status. Each of them is
+// got once per tick, however many waiters wait on it.
+func (p *Poller[O]) WithRefresh(get func(ctx context.Context, name string) (*O, error),
+	complete func(o *O) bool,
+) *Poller[O] {
+	p.get = get
+	p.complete = complete
+	return p
+}
+
+// Get returns the named object as listed on the next tick, or a not found
+// error when it isn't listed. The list call is shared with every other get
+// waiting on the tick.
+func (p *Poller[O]) Get(ctx context.Context, name string) (*O, error) {
+	ch := make(chan result[O], 1)
+
+	p.mu.Lock()
+	p.waiters[name] = append(p.waiters[name], ch)
+	if !p.running {
+		p.running = true
+		// keep the logger of the context without ending with it, as the
+		// ticks outlive the get starting them
+		go p.run(context.WithoutCancel(ctx))
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-ch:
+		return r.o, r.err
+	}
+}
+
+// run lists the objects on every tick, until a tick finds no waiter.
+func (p *Poller[O]) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		p.mu.Lock()
+		waiters := p.waiters
+		p.waiters = make(map[string][]chan result[O])
+		if len(waiters) == 0 {
+			p.running = false
+			p.mu.Unlock()
+			return
+		}
+		p.mu.Unlock()
+
+		p.tick(ctx, waiters)
+	}
+}
+
+// tick lists the objects once and hands them to the waiters.
+func (p *Poller[O]) tick(ctx context.Context, waiters map[string][]chan result[O]) {
+	ctx, cancel := context.WithTimeout(ctx, listTimeout)
+	defer cancel()
+
+	// the objects are waited on to change, so cached reads won't do
+	objects, err := p.list(catalyst.Fresh(ctx))
+
+	tflog.Debug(ctx, "listed "+p.kind+"s for waiters",
+		map[string]interface{}{
+			"waiters": len(waiters),
+			"objects": len(objects),
+		})
+
+	byName := make(map[string]*O, len(objects))
+	for i := range objects {
+		byName[p.name(&objects[i])] = &objects[i]
+	}
+
+	for name, chs := range waiters {
+		r := result[O]{err: err}
+		if err == nil {
+			r.o = byName[name]
+			if r.o == nil {
+				r.err = diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+			} else if p.get != nil && !p.complete(r.o) {
+				r.o, r.err = p.get(catalyst.Fresh(ctx), name)
+			}
+		}
+
+		for _, ch := range chs {
+			ch <- r
+		}
+	}
+}
+
+// Projects returns a poller of the projects of the client. Projects listed
+// without their status are got for it.
+func Projects(client catalyst.Client) *Poller[cloudruntime_client.Project] {
+	return New("project", client.ListProjects, func(project *cloudruntime_client.Project) string {
+		return lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+	}).WithRefresh(func(ctx context.Context, name string) (*cloudruntime_client.Project, error) {
+		return client.GetProject(ctx, name, &cloudruntime_client.DescribeProjectParams{})
+	}, func(project *cloudruntime_client.Project) bool {
+		return lo.FromPtr(lo.FromPtr(project.Status).Status) != ""
+	})
+}
+
+// Regions returns a poller of the regions of the client. Regions listed
+// without their status are got for it.
+func Regions(client catalyst.Client) *Poller[cloudruntime_client.Region] {
+	return New("region", client.ListRegions, func(region *cloudruntime_client.Region) string {
+		return lo.FromPtr(lo.FromPtr(region.Metadata).Name)
+	}).WithRefresh(client.GetRegion, func(region *cloudruntime_client.Region) bool {
+		return lo.FromPtr(lo.FromPtr(region.Status).Status) != ""
+	})
+}
@@ -0,0 +1,144 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
+)
+
+type object struct {
+	name   string
+	status string
+}
+
+func newPoller(lists *atomic.Int32, err error, objects ...object) *poller.Poller[object] {
+	return poller.New("object",
+		func(context.Context) ([]object, error) {
+			lists.Add(1)
+			return objects, err
+		},
+		func(o *object) string {
+			return o.name
+		},
+	).WithInterval(20 * time.Millisecond)
+}
+
+func TestPollerSharesListCalls(t *testing.T) {
+	var lists atomic.Int32
+	p := newPoller(&lists, nil, object{"a", "ready"}, object{"b", "processing"}).
+		WithInterval(200 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		name := "a"
+		if i%2 == 1 {
+			name = "b"
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			o, err := p.Get(context.Background(), name)
+			if err != nil {
+				t.Errorf("getting %s: %s", name, err)
+				return
+			}
+			if o.name != name {
+				t.Errorf("expected object %s, got %s", name, o.name)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := lists.Load(); n != 1 {
+		t.Errorf("expected waiters to share a single list call, got %d", n)
+	}
+
+	// the poller stops once no one waits, and starts again on demand
+	time.Sleep(300 * time.Millisecond)
+	if _, err := p.Get(context.Background(), "a"); err != nil {
+		t.Errorf("getting a again: %s", err)
+	}
+	if n := lists.Load(); n != 2 {
+		t.Errorf("expected a second list call, got %d", n)
+	}
+}
+
+func TestPollerRefreshesObjectsListedWithoutStatus(t *testing.T) {
+	var lists, gets atomic.Int32
+	p := newPoller(&lists, nil, object{"a", ""}, object{"b", "processing"}).
+		WithInterval(200*time.Millisecond).
+		WithRefresh(func(_ context.Context, name string) (*object, error) {
+			gets.Add(1)
+			return &object{name, "ready"}, nil
+		}, func(o *object) bool {
+			return o.status != ""
+		})
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		name := "a"
+		if i%2 == 1 {
+			name = "b"
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			o, err := p.Get(context.Background(), name)
+			if err != nil {
+				t.Errorf("getting %s: %s", name, err)
+				return
+			}
+			if name == "a" && o.status != "ready" {
+				t.Errorf("expected %s to be refreshed, got status %q", name, o.status)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// only the object listed without status is got, once for its waiters
+	if n := gets.Load(); n != 1 {
+		t.Errorf("expected a single get of the object listed without status, got %d", n)
+	}
+}
+
+func TestPollerNotFound(t *testing.T) {
+	var lists atomic.Int32
+	p := newPoller(&lists, nil, object{"a", "ready"})
+
+	if _, err := p.Get(context.Background(), "missing"); !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestPollerListError(t *testing.T) {
+	var lists atomic.Int32
+	listErr := errors.New("service unavailable")
+	p := newPoller(&lists, listErr)
+
+	if _, err := p.Get(context.Background(), "a"); !errors.Is(err, listErr) {
+		t.Errorf("expected the list error, got %v", err)
+	}
+}
+
+func TestPollerContext(t *testing.T) {
+	var lists atomic.Int32
+	p := newPoller(&lists, nil, object{"a", "ready"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := p.Get(ctx, "a"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the get to end with its context, got %v", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -50,11 +52,13 @@ func (d *projectDataSource) Schema(ctx context.Context,
 				Optional:            true,
 			},
 			"grpc_endpoint": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "gRPC endpoint",
 				Optional:            true,
 				Computed:            true,
 			},
 			"http_endpoint": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "HTTP endpoint",
 				Optional:            true,
 				Computed:            true,
@@ -110,8 +114,7 @@ func (d *projectDataSource) Read(ctx context.Context,
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading project datasource: %s", err))
+		apierrors.AddError(&resp.Diagnostics, err, "error reading project datasource")
 		return
 	}
 
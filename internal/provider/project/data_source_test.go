@@ -135,6 +135,16 @@ func mockDatasourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			ListRegions(gomock.Any()).
+			DoAndReturn(func(_ context.Context) ([]cloudruntime_client.Region, error) {
+				if region == nil {
+					return nil, nil
+				}
+				return []cloudruntime_client.Region{*region}, nil
+			}).
+			AnyTimes()
+
 		c.EXPECT().
 			DeleteRegion(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, name string) error {
@@ -168,7 +178,7 @@ func mockDatasourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider
 					Kind:       lo.ToPtr(catalyst.KindProject),
 					Metadata: &cloudruntime_client.Metadata{
 						Uid:  lo.ToPtr(strconv.FormatInt(rand.Int63(), 10)),
-						Name: lo.ToPtr(projectName),
+						Name: lo.ToPtr(name),
 					},
 					Spec: &cloudruntime_client.ProjectSpec{
 						Region: lo.ToPtr(regionName),
@@ -188,6 +198,26 @@ func mockDatasourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			ListProjects(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) ([]cloudruntime_client.Project, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				var projects []cloudruntime_client.Project
+				for name := range projs {
+					projects = append(projects, cloudruntime_client.Project{
+						Metadata: &cloudruntime_client.Metadata{
+							Name: lo.ToPtr(name),
+						},
+						Spec: &cloudruntime_client.ProjectSpec{
+							Region: lo.ToPtr(regionName),
+						},
+					})
+				}
+				return projects, nil
+			}).
+			AnyTimes()
+
 		c.EXPECT().
 			DeleteProject(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, name string) error {
@@ -0,0 +1,298 @@
+package project_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+)
+
+func TestFakeAPIProjectResourceWaitsForReady(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(2))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+						resource.TestCheckResourceAttrSet("catalyst_project.test", "http_endpoint"),
+						// the project list is polled until the project leaves
+						// processing
+						func(_ *terraform.State) error {
+							lists := api.Requests(http.MethodGet, "/projects") - api.Requests(http.MethodGet, "/projects/")
+							if lists < 3 {
+								return fmt.Errorf("expected the projects to be listed at least 3 times, got %d", lists)
+							}
+							return nil
+						},
+					),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceCreateError(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "/projects", Status: http.StatusInternalServerError, Times: 1})
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ExpectError: regexp.MustCompile(`Error creating project`),
+				},
+				// nothing was created, so applying again creates the project
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceAPIErrors(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				// invalid fields are reported on their attributes, along
+				// with what identifies the request
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{
+							Method: http.MethodPost,
+							Path:   "/projects",
+							Status: http.StatusUnprocessableEntity,
+							Times:  1,
+							Code:   "InvalidRegion",
+							Fields: map[string]string{"spec.region": "region has no capacity left"},
+						})
+					},
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ExpectError: regexp.MustCompile(
+						`(?s)Invalid Attribute Value.*region has no capacity left.*InvalidRegion,\s+request\s+ID\s+req-\d+`),
+				},
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "/projects", Status: http.StatusForbidden, Times: 1})
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ExpectError: regexp.MustCompile(`(?s)Permission Denied.*CATALYST_API_KEY`),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceCreateTimeout(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						api.StickProject(projectName, "processing")
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "3s"),
+					ExpectError: regexp.MustCompile(`context deadline exceeded`),
+				},
+				// the project that never became ready is tainted and replaced
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccProjectResourceConfigWithTimeouts(projectName, "3s"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourcePartialState(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				// the project is created but checking on it keeps failing
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{Method: http.MethodGet, Path: "/projects", Status: http.StatusInternalServerError})
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ExpectError: regexp.MustCompile(`Error getting project`),
+				},
+				// it is still tracked, tainted, rather than leaked
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceTransientErrors(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(1))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				// a few failed checks while waiting don't fail the apply
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{Method: http.MethodGet, Path: "/projects", Status: http.StatusBadGateway, Times: 2})
+					},
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					Check:  resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceNotFound(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+				},
+				// a project deleted outside of Terraform is created again
+				{
+					PreConfig: func() {
+						api.DropProject(projectName)
+					},
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: resource.TestCheckResourceAttrSet("catalyst_project.test", "grpc_endpoint"),
+				},
+				// a project lost right after being accepted fails the create
+				{
+					PreConfig: func() {
+						api.DropProject(projectName)
+						api.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "/projects", Drop: true})
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ExpectError: regexp.MustCompile(`Error getting project`),
+				},
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestFakeAPIProjectResourceDeleteError(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+				},
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{Method: http.MethodDelete, Path: "/projects/" + projectName, Status: http.StatusInternalServerError, Times: 1})
+					},
+					Config:      testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Error deleting project`),
+				},
+				// the project is kept in state, so destroying it again works
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+					Check: func(_ *terraform.State) error {
+						if _, ok := api.Project(projectName); !ok {
+							return fmt.Errorf("expected project %s to still exist", projectName)
+						}
+						return nil
+					},
+				},
+			},
+		})
+}
+
+// newFaultyAPI starts a fake API holding the region projects are created in.
+func newFaultyAPI(t *testing.T, opts ...fakeapi.Option) *fakeapi.Server {
+	t.Helper()
+
+	api := fakeapi.New(t, opts...)
+	api.AddRegion(cloudruntime_client.Region{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindRegion),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(regionName),
+		},
+		Spec: &cloudruntime_client.RegionSpec{
+			Host:     lo.ToPtr(regionHost),
+			Ingress:  lo.ToPtr(regionIngress),
+			Location: lo.ToPtr(regionLocation),
+		},
+	})
+
+	return api
+}
+
+func testAccProjectResourceConfigWithTimeouts(name, create string) string {
+	return fmt.Sprintf(`
+resource "catalyst_project" "test" {
+  region = %q
+  name = %q
+  wait_for_ready = true
+
+  timeouts {
+    create = %q
+  }
+}
+`, regionName, name, create)
+}
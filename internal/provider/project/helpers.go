@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 
 	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
 )
 
 func read(ctx context.Context,
@@ -24,21 +29,78 @@ func read(ctx context.Context,
 		return fmt.Errorf("error getting project: %w", err)
 	}
 
+	m.fromProject(project)
+
 	m.Log(ctx, "read project")
 
-	m.SetName(*project.Metadata.Name)
-	m.SetRegion(*project.Spec.Region)
-	if project.Status.Endpoints != nil &&
-		project.Status.Endpoints.Http != nil &&
-		project.Status.Endpoints.Http.Url != nil {
-		m.SetHTTPEndpoint(*project.Status.Endpoints.Http.Url)
+	return nil
+
+}
+
+// Endpoints reads the project and returns its gRPC and HTTP endpoints, for
+// resources that connect workloads to the project.
+func Endpoints(ctx context.Context,
+	client catalyst.Client,
+	name string,
+) (grpcEndpoint, httpEndpoint string, err error) {
+	m := NewModel()
+	m.SetName(name)
+
+	if err := read(ctx, client, m); err != nil {
+		return "", "", err
 	}
-	if project.Status.Endpoints != nil &&
-		project.Status.Endpoints.Grpc != nil &&
-		project.Status.Endpoints.Grpc.Url != nil {
-		m.SetGRPCEndpoint(*project.Status.Endpoints.Grpc.Url)
+
+	return m.GRPCEndpoint.ValueString(), m.HTTPEndpoint.ValueString(), nil
+}
+
+// validateRegion looks up the region a project refers to and reports
+// problems against the given attribute path. A missing region is only
+// a warning since it may be created in the same run as the project.
+func validateRegion(ctx context.Context,
+	client catalyst.Client,
+	attrPath path.Path,
+	name string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "validating project region",
+		map[string]interface{}{
+			"region": name,
+		})
+
+	region, err := client.GetRegion(ctx, name)
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			diags.AddAttributeWarning(attrPath, "Region Not Found",
+				fmt.Sprintf("Region %q does not exist. This can be ignored if the region is created in the same run, "+
+					"otherwise creating the project will fail.", name))
+			return diags
+		}
+
+		apierrors.AddError(&diags, err, fmt.Sprintf("error getting region %q", name),
+			apierrors.AtAttribute(attrPath))
+		return diags
 	}
 
-	return nil
+	if region.Kind != nil &&
+		*region.Kind != catalyst.KindRegion {
+		diags.AddAttributeError(attrPath, "Invalid Region",
+			fmt.Sprintf("%q is a %s, expected a %s", name, *region.Kind, catalyst.KindRegion))
+		return diags
+	}
+
+	if region.Status == nil ||
+		lo.FromPtr(region.Status.Status) != "ready" {
+		diags.AddAttributeWarning(attrPath, "Region Not Ready",
+			fmt.Sprintf("Region %q is not ready, the project may not become ready until it is.", name))
+	}
+
+	if region.Spec != nil &&
+		lo.FromPtr(region.Spec.Type) == catalyst.RegionTypePrivate &&
+		(region.Status == nil || !lo.FromPtr(region.Status.Connected)) {
+		diags.AddAttributeWarning(attrPath, "Region Not Connected",
+			fmt.Sprintf("Private region %q has no connected clusters, the project will not be reachable until one joins.", name))
+	}
 
+	return diags
 }
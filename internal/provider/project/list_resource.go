@@ -0,0 +1,160 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &projectListResource{}
+var _ list.ListResourceWithConfigure = &projectListResource{}
+
+// projectListResource enumerates the projects of the organization.
+type projectListResource struct {
+	client catalyst.Client
+}
+
+// listModel describes the filters of the list resource.
+type listModel struct {
+	Region     types.String `tfsdk:"region"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func NewListResource() list.ListResource {
+	return &projectListResource{}
+}
+
+func (p *projectListResource) Metadata(ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (p *projectListResource) ListResourceConfigSchema(ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Catalyst projects of the organization",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only list projects in this region",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list projects whose name starts with this prefix",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *projectListResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	p.client = providerData.Client
+}
+
+func (p *projectListResource) List(ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter listModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	orgID, err := helpers.OrganizationID(ctx, p.client)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error getting organization")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projects, err := p.client.ListProjects(ctx)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error listing projects")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "listed projects", map[string]interface{}{
+		"count":       len(projects),
+		"region":      filter.Region.ValueString(),
+		"name_prefix": filter.NamePrefix.ValueString(),
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, project := range projects {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			name := lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+			if name == "" ||
+				!strings.HasPrefix(name, filter.NamePrefix.ValueString()) {
+				continue
+			}
+
+			model := NewResourceModel()
+			model.SetName(name)
+			model.fromProject(&project)
+			if !filter.Region.IsNull() &&
+				model.GetRegion() != filter.Region.ValueString() {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(result.Identity.Set(ctx, helpers.Identity{
+				OrganizationID: types.StringValue(orgID),
+				Name:           types.StringValue(name),
+			})...)
+
+			if req.IncludeResource {
+				model.WaitForReady = types.BoolValue(true)
+				model.SetAdoptExisting(false)
+				model.SetDeletionProtection(false)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
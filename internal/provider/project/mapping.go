@@ -0,0 +1,63 @@
+package project
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// attributes maps the fields of the API object to the attributes they are
+// set from.
+var attributes = map[string]path.Path{
+	"metadata.name":    path.Root("name"),
+	"spec.displayName": path.Root("name"),
+	"spec.region":      path.Root("region"),
+}
+
+// toProject builds the API object for the model.
+func (m *model) toProject() *client.Project {
+	return &client.Project{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindProject),
+		Metadata: &client.Metadata{
+			Name: lo.ToPtr(m.GetName()),
+		},
+		Spec: &client.ProjectSpec{
+			DisplayName: lo.ToPtr(m.GetName()),
+			Region:      helpers.StringPointer(m.Region),
+		},
+		Status: &client.ProjectStatus{},
+	}
+}
+
+// fromProject updates the model with the API object. Any part of the object
+// may be missing, in which case the attributes it holds are left empty.
+func (m *model) fromProject(project *client.Project) {
+	if project == nil {
+		project = &client.Project{}
+	}
+	metadata := lo.FromPtr(project.Metadata)
+	spec := lo.FromPtr(project.Spec)
+	endpoints := lo.FromPtr(lo.FromPtr(project.Status).Endpoints)
+
+	if metadata.Name != nil {
+		m.SetName(*metadata.Name)
+	}
+	m.Region = helpers.StringFromAPI(m.Region, spec.Region)
+	m.GRPCEndpoint = customtypes.URL{StringValue: helpers.StringFromAPI(m.GRPCEndpoint.StringValue, lo.FromPtr(endpoints.Grpc).Url)}
+	m.HTTPEndpoint = customtypes.URL{StringValue: helpers.StringFromAPI(m.HTTPEndpoint.StringValue, lo.FromPtr(endpoints.Http).Url)}
+}
+
+// projectStatus returns the status of the project, or an empty string when
+// the API didn't report one.
+func projectStatus(project *client.Project) string {
+	if project == nil || project.Status == nil {
+		return ""
+	}
+	return lo.FromPtr(project.Status.Status)
+}
@@ -0,0 +1,54 @@
+package project
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+)
+
+func FuzzProjectFromAPI(f *testing.F) {
+	f.Add([]byte(`null`))
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"metadata":{}}`))
+	f.Add([]byte(`{"spec":{}, "status":{}}`))
+	f.Add([]byte(`{"status":{"endpoints":{}}}`))
+	f.Add([]byte(`{"status":{"endpoints":{"grpc":{},"http":null}}}`))
+	f.Add([]byte(`{"metadata":{"name":"project"},"spec":{"displayName":"project","region":"region"},"status":{"status":"ready","endpoints":{"grpc":{"url":"grpc.example.com:443"},"http":{"url":"https://http.example.com"}}}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var project *client.Project
+		if err := json.Unmarshal(data, &project); err != nil {
+			t.Skip()
+		}
+
+		m := &model{
+			Name:         types.StringValue("project"),
+			Region:       types.StringUnknown(),
+			GRPCEndpoint: customtypes.NewURLUnknown(),
+			HTTPEndpoint: customtypes.NewURLUnknown(),
+		}
+		m.fromProject(project)
+		projectStatus(project)
+
+		if m.Name.IsNull() || m.Name.IsUnknown() {
+			t.Errorf("name: expected a value, got %s", m.Name)
+		}
+		for attr, value := range map[string]interface{ IsUnknown() bool }{
+			"region":        m.Region,
+			"grpc_endpoint": m.GRPCEndpoint,
+			"http_endpoint": m.HTTPEndpoint,
+		} {
+			if value.IsUnknown() {
+				t.Errorf("%s: expected a known value after mapping", attr)
+			}
+		}
+
+		// whatever was read must map back without panicking
+		m.toProject()
+	})
+}
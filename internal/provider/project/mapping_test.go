@@ -0,0 +1,61 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+)
+
+func TestProjectMappingRoundTrip(t *testing.T) {
+	m := &model{
+		Name:   types.StringValue("project"),
+		Region: types.StringValue("region"),
+	}
+
+	project := m.toProject()
+	if lo.FromPtr(project.Spec.Region) != "region" {
+		t.Fatalf("expected region to be sent, got %v", lo.FromPtr(project.Spec.Region))
+	}
+
+	// the API responds with what it stored, and the endpoints of the project
+	project.Status = &client.ProjectStatus{
+		Endpoints: &client.ProjectStatusEndpoint{
+			Grpc: &client.ProjectStatusEndpointDetails{Url: lo.ToPtr("https://grpc.example.com")},
+			Http: &client.ProjectStatusEndpointDetails{Url: lo.ToPtr("https://http.example.com")},
+		},
+	}
+
+	m.fromProject(project)
+	if !m.Region.Equal(types.StringValue("region")) {
+		t.Errorf("expected region to be stored, got %s", m.Region)
+	}
+	if !m.GRPCEndpoint.Equal(customtypes.NewURLValue("https://grpc.example.com")) {
+		t.Errorf("expected gRPC endpoint to be stored, got %s", m.GRPCEndpoint)
+	}
+	if !m.HTTPEndpoint.Equal(customtypes.NewURLValue("https://http.example.com")) {
+		t.Errorf("expected HTTP endpoint to be stored, got %s", m.HTTPEndpoint)
+	}
+}
+
+func TestProjectMappingNoEndpoints(t *testing.T) {
+	m := &model{
+		Name:         types.StringValue("project"),
+		Region:       types.StringNull(),
+		GRPCEndpoint: customtypes.URL{StringValue: types.StringUnknown()},
+		HTTPEndpoint: customtypes.URL{StringValue: types.StringUnknown()},
+	}
+
+	// a project without status has no endpoints yet
+	m.fromProject(m.toProject())
+	if !m.GRPCEndpoint.StringValue.IsNull() || !m.HTTPEndpoint.StringValue.IsNull() {
+		t.Errorf("expected no endpoints to be stored, got %s and %s", m.GRPCEndpoint, m.HTTPEndpoint)
+	}
+	if !m.Region.IsNull() {
+		t.Errorf("expected no region to be stored, got %s", m.Region)
+	}
+}
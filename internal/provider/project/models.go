@@ -4,22 +4,40 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
 )
 
 type model struct {
-	Name         types.String `tfsdk:"name"`
-	Region       types.String `tfsdk:"region"`
-	GRPCEndpoint types.String `tfsdk:"grpc_endpoint"`
-	HTTPEndpoint types.String `tfsdk:"http_endpoint"`
-	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+	Name         types.String    `tfsdk:"name"`
+	Region       types.String    `tfsdk:"region"`
+	GRPCEndpoint customtypes.URL `tfsdk:"grpc_endpoint"`
+	HTTPEndpoint customtypes.URL `tfsdk:"http_endpoint"`
+	WaitForReady types.Bool      `tfsdk:"wait_for_ready"`
 }
 
 func NewModel() *model {
 	return &model{}
 }
 
+// resourceModel extends the data source model with resource only attributes.
+type resourceModel struct {
+	model
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewResourceModel() *resourceModel {
+	return &resourceModel{
+		Timeouts: helpers.NullTimeouts(),
+	}
+}
+
 func (m *model) Log(ctx context.Context, msg string) {
 	tflog.Debug(ctx, msg, map[string]interface{}{
 		"name":          m.GetName(),
@@ -61,7 +79,7 @@ func (m *model) GetGRPCEndpoint() string {
 }
 
 func (m *model) SetGRPCEndpoint(endpoint string) {
-	m.GRPCEndpoint = types.StringValue(endpoint)
+	m.GRPCEndpoint = customtypes.NewURLValue(endpoint)
 }
 
 func (m *model) GetHTTPEndpoint() string {
@@ -69,5 +87,25 @@ func (m *model) GetHTTPEndpoint() string {
 }
 
 func (m *model) SetHTTPEndpoint(endpoint string) {
-	m.HTTPEndpoint = types.StringValue(endpoint)
+	m.HTTPEndpoint = customtypes.NewURLValue(endpoint)
+}
+
+func (m *resourceModel) GetTimeouts() timeouts.Value {
+	return m.Timeouts
+}
+
+func (m *resourceModel) GetAdoptExisting() bool {
+	return m.AdoptExisting.ValueBool()
+}
+
+func (m *resourceModel) SetAdoptExisting(adopt bool) {
+	m.AdoptExisting = types.BoolValue(adopt)
+}
+
+func (m *resourceModel) GetDeletionProtection() bool {
+	return m.DeletionProtection.ValueBool()
+}
+
+func (m *resourceModel) SetDeletionProtection(protect bool) {
+	m.DeletionProtection = types.BoolValue(protect)
 }
@@ -3,34 +3,76 @@ package project
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/samber/lo"
 
 	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
 	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/lifecycle"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &projectResource{}
 var _ resource.ResourceWithImportState = &projectResource{}
+var _ resource.ResourceWithIdentity = &projectResource{}
+var _ resource.ResourceWithUpgradeState = &projectResource{}
+var _ resource.ResourceWithModifyPlan = &projectResource{}
 
 // projectResource defines the resource implementation.
 type projectResource struct {
-	client catalyst.Client
+	*lifecycle.Resource[*resourceModel, client.Project]
 }
 
 func NewResource() resource.Resource {
-	return &projectResource{}
+	return &projectResource{
+		Resource: lifecycle.New(lifecycle.Definition[*resourceModel, client.Project]{
+			Kind:     "project",
+			NewModel: NewResourceModel,
+			FromAPI: func(m *resourceModel, project *client.Project) {
+				m.fromProject(project)
+			},
+			ImportDefaults: func(m *resourceModel) {
+				m.SetAdoptExisting(false)
+				m.SetDeletionProtection(false)
+			},
+			Get:    getProject,
+			Create: createProject,
+			Update: updateProject,
+			Delete: func(ctx context.Context, c catalyst.Client, m *resourceModel) error {
+				return c.DeleteProject(ctx, m.GetName())
+			},
+			Ready: func(m *resourceModel, project *client.Project) bool {
+				// projects not waited for only need to have been accepted
+				expectedStatus := "processing"
+				if m.WaitForReady.ValueBool() {
+					expectedStatus = "ready"
+				}
+
+				return projectStatus(project) == expectedStatus
+			},
+			Status:     projectStatus,
+			Attributes: attributes,
+			Poller: func(providerData data.ProviderData) *poller.Poller[client.Project] {
+				return providerData.Projects
+			},
+		}),
+	}
 }
 
 func (p *projectResource) Metadata(ctx context.Context,
@@ -47,21 +89,30 @@ func (p *projectResource) Schema(ctx context.Context,
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Catalyst project resource",
+		Version:             schemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Project name",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Project region",
 				Optional:            true,
 			},
 			"grpc_endpoint": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "gRPC endpoint",
 				Optional:            true,
 				Computed:            true,
 			},
 			"http_endpoint": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "HTTP endpoint",
 				Optional:            true,
 				Computed:            true,
@@ -75,295 +126,123 @@ func (p *projectResource) Schema(ctx context.Context,
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing project with the same name instead of failing to create it",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the project from being destroyed while set to true",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
-func (p *projectResource) Configure(ctx context.Context,
-	req resource.ConfigureRequest,
-	resp *resource.ConfigureResponse,
-) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	providerData, ok := req.ProviderData.(data.ProviderData)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	p.client = providerData.Client
-}
-
-func (p *projectResource) Create(ctx context.Context,
-	req resource.CreateRequest,
-	resp *resource.CreateResponse,
-) {
-	model := NewModel()
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, "creating project",
-		map[string]interface{}{
-			"name":   model.GetName(),
-			"region": model.GetRegion(),
-		})
-
-	project := &client.Project{
-		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
-		Kind:       lo.ToPtr(catalyst.KindProject),
-		Metadata: &client.Metadata{
-			Name: lo.ToPtr(model.GetName()),
-		},
-		Spec: &client.ProjectSpec{
-			DisplayName: lo.ToPtr(model.GetName()),
-			Region:      lo.ToPtr(model.GetRegion()),
+func (p *projectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   schemaV0(),
+			StateUpgrader: upgradeStateV0,
 		},
-		Status: &client.ProjectStatus{},
-	}
-	if err := p.client.CreateProject(ctx, project); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error creating project: %s", err))
-		return
 	}
-
-	// wait until project is created and in ready status
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		project, err := p.client.GetProject(ctx, model.GetName(), &client.DescribeProjectParams{})
-		if err != nil {
-			return false, fmt.Errorf("Error getting project: %w", err)
-		}
-
-		expectedStatus := "processing"
-		if model.WaitForReady.ValueBool() {
-			expectedStatus = "ready"
-		}
-
-		if project.Status != nil &&
-			project.Status.Status != nil &&
-			*project.Status.Status == expectedStatus {
-			return true, nil
-		}
-
-		tflog.Debug(ctx, "project status still not at expected value",
-			map[string]interface{}{
-				"name":     model.GetName(),
-				"expected": expectedStatus,
-			})
-
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting project: %s", err))
-		return
-	}
-
-	tflog.Debug(ctx, "created project", map[string]interface{}{
-		"id":     project.Metadata.Uid,
-		"name":   *project.Metadata.Name,
-		"region": *project.Spec.Region,
-	})
-
-	if err := read(ctx, p.client, model); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading created project: %s", err))
-		return
-	}
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-func (p *projectResource) Read(ctx context.Context,
-	req resource.ReadRequest,
-	resp *resource.ReadResponse,
+func (p *projectResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
 ) {
-	model := NewModel()
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if err := read(ctx, p.client, model); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "project not found", map[string]interface{}{
-				"name": model.GetName(),
-			})
-
-			resp.State.RemoveResource(ctx)
-			return
-		}
-
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading project: %s", err))
+	// nothing to validate when destroying or before the provider is configured
+	if req.Plan.Raw.IsNull() || p.Client() == nil {
 		return
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
-}
-
-func (p *projectResource) Update(ctx context.Context,
-	req resource.UpdateRequest,
-	resp *resource.UpdateResponse,
-) {
-	model := NewModel()
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	var region types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	project, err := p.client.GetProject(ctx, model.GetName(), &client.DescribeProjectParams{})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting project: %s", err))
-		return
-	}
-
-	model.Log(ctx, "read project")
-
-	project.Spec.DisplayName = lo.ToPtr(model.GetName())
-	project.Spec.Region = lo.ToPtr(model.GetRegion())
-	project.Status = &client.ProjectStatus{}
-
-	tflog.Debug(ctx, "updating project", map[string]interface{}{
-		"model": model.String(),
-	})
-
-	if err := p.client.UpdateProject(ctx, project); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error updating project: %s", err))
+	// an unknown region is computed from another resource in this run
+	if region.IsNull() || region.IsUnknown() || region.ValueString() == "" {
 		return
 	}
 
-	// wait until project is created and in ready status
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		project, err := p.client.GetProject(ctx, model.GetName(), &client.DescribeProjectParams{})
-		if err != nil {
-			return false, fmt.Errorf("Error getting project: %w", err)
-		}
-
-		expectedStatus := "processing"
-		if model.WaitForReady.ValueBool() {
-			expectedStatus = "ready"
-		}
-
-		if project.Status != nil &&
-			project.Status.Status != nil &&
-			*project.Status.Status == expectedStatus {
-			return true, nil
+	// only check the region when creating the project or moving it
+	if !req.State.Raw.IsNull() {
+		var priorRegion types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &priorRegion)...)
+		if resp.Diagnostics.HasError() || priorRegion.Equal(region) {
+			return
 		}
-
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting project: %s", err))
-		return
 	}
 
-	if err := read(ctx, p.client, model); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading updated project: %s", err))
-		return
-	}
-
-	tflog.Debug(ctx, "updated project", map[string]interface{}{
-		"model": model.String(),
-	})
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(validateRegion(ctx, p.Client(), path.Root("region"), region.ValueString())...)
 }
 
-func (p *projectResource) Delete(ctx context.Context,
-	req resource.DeleteRequest,
-	resp *resource.DeleteResponse,
-) {
-	model := NewModel()
+func getProject(ctx context.Context,
+	c catalyst.Client,
+	name string,
+) (*client.Project, error) {
+	return c.GetProject(ctx, name, &client.DescribeProjectParams{})
+}
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
+func createProject(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+) error {
+	err := c.CreateProject(ctx, m.toProject())
+	// only a conflict tells the project already exists, other errors may
+	// come from a project that isn't ours or from a create that landed
+	if err == nil || !m.GetAdoptExisting() || apierrors.Parse(err).StatusCode != http.StatusConflict {
+		return err
+	}
+
+	// the project may already exist, in which case we take it over
+	if adoptErr := adopt(ctx, c, m); adoptErr != nil {
+		if !diagrid_errors.IsResourceNotFoundError(adoptErr) {
+			err = fmt.Errorf("%w; adopting existing project: %w", err, adoptErr)
+		}
+		return err
 	}
 
-	tflog.Debug(ctx, "deleting project",
+	tflog.Debug(ctx, "adopted existing project",
 		map[string]interface{}{
-			"name": model.GetName(),
+			"name": m.GetName(),
 		})
 
-	if err := p.client.DeleteProject(ctx, model.GetName()); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "project to delete not found", map[string]interface{}{
-				"name": model.GetName(),
-			})
-			return
-		}
-
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error deleting project: %s", err))
-		return
-	}
-
-	// wait until project is gone
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		_, err := p.client.GetProject(ctx, model.GetName(), &client.DescribeProjectParams{})
-		if err != nil {
-			if diagrid_errors.IsResourceNotFoundError(err) {
-				return true, nil
-			}
-
-			return false, fmt.Errorf("error checking for deleted project: %w", err)
-		}
+	return nil
+}
 
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting project: %s", err))
-		return
+// adopt takes over an existing project with the same name, updating it to
+// match the planned spec.
+func adopt(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+) error {
+	existing, err := getProject(ctx, c, m.GetName())
+	if err != nil {
+		return err
 	}
 
-	tflog.Debug(ctx, "deleted project",
-		map[string]interface{}{
-			"name": model.GetName(),
-		})
+	return updateProject(ctx, c, m, existing)
 }
 
-func (p *projectResource) ImportState(ctx context.Context,
-	req resource.ImportStateRequest,
-	resp *resource.ImportStateResponse,
-) {
-	model := NewModel()
-	model.SetName(req.ID)
-
-	if err := read(ctx, p.client, model); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "project not found", map[string]interface{}{
-				"name": model.GetName(),
-			})
-
-			return
-		}
-
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading imported project: %s", err))
-		return
-	}
+func updateProject(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+	project *client.Project,
+) error {
+	planned := m.toProject()
+	if project.Spec == nil {
+		project.Spec = &client.ProjectSpec{}
+	}
+	project.Spec.DisplayName = planned.Spec.DisplayName
+	project.Spec.Region = planned.Spec.Region
+	project.Status = &client.ProjectStatus{}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	return c.UpdateProject(ctx, project)
 }
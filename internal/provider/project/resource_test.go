@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -15,9 +16,14 @@ import (
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
 	"github.com/diagridio/terraform-provider-catalyst/internal/test/acceptance"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/recorder"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/samber/lo"
 	"go.uber.org/mock/gomock"
 )
@@ -61,20 +67,28 @@ func testSteps() []resource.TestStep {
 }
 
 func TestAccProjectResource(t *testing.T) {
-	resource.Test(t,
+	// replays testdata/cassettes/TestAccProjectResource.json unless recording
+	rec := recorder.New(t)
+	rec.Substitute("region_name", regionName)
+	rec.Substitute("region_host", regionHost)
+	rec.Substitute("project_name", projectName)
+
+	rec.Test(t,
 		resource.TestCase{
-			PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
-			ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
-			Steps:                    testSteps(),
+			PreCheck: func() {
+				if rec.Recording() {
+					acceptance.TestAccPreCheck(t)
+				}
+			},
+			Steps: testSteps(),
 		})
 }
 
 func TestMockProjectResource(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
-	resource.Test(t,
+	resource.UnitTest(t,
 		resource.TestCase{
-			PreCheck: func() { acceptance.TestAccPreCheck(t) },
 			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 				provider.ProviderName: providerserver.NewProtocol6WithError(
 					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
@@ -84,6 +98,375 @@ func TestMockProjectResource(t *testing.T) {
 		})
 }
 
+func TestFakeAPIProjectResource(t *testing.T) {
+	api := fakeapi.New(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps:                    testSteps(),
+		})
+}
+
+func TestFakeAPIProjectResourceRename(t *testing.T) {
+	api := newFaultyAPI(t, fakeapi.WithReadyAfter(0))
+	renamed := acctest.RandomWithPrefix("prj")
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(projectName, "1m"),
+				},
+				// the name is the key of the project, so renaming it replaces
+				// the project
+				{
+					Config: testAccProjectResourceConfigWithTimeouts(renamed, "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_project.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: func(_ *terraform.State) error {
+						if _, ok := api.Project(projectName); ok {
+							return fmt.Errorf("expected project %s to be deleted", projectName)
+						}
+						if _, ok := api.Project(renamed); !ok {
+							return fmt.Errorf("expected project %s to be created", renamed)
+						}
+						return nil
+					},
+				},
+			},
+		})
+}
+
+func TestMockProjectResourceImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfig(projectName),
+				},
+				{
+					ResourceName:                         "catalyst_project.test",
+					ImportState:                          true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					ImportStateId:                        fmt.Sprintf("%s/%s", orgID, projectName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIgnore:              []string{"wait_for_ready"},
+				},
+				{
+					ResourceName:  "catalyst_project.test",
+					ImportState:   true,
+					ImportStateId: fmt.Sprintf("%s/missing", orgID),
+					ExpectError:   regexp.MustCompile(`Cannot Import Non-Existent Object`),
+				},
+			},
+		})
+}
+
+func TestMockProjectResourceInvalidRegion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(
+						func(endpoint, apiKey string) (catalyst.Client, error) {
+							c := catalyst.NewMockClient(ctrl)
+
+							// the referenced name resolves to something that isn't a region
+							c.EXPECT().
+								GetRegion(gomock.Any(), gomock.Any()).
+								Return(&cloudruntime_client.Region{
+									ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+									Kind:       lo.ToPtr(catalyst.KindProject),
+									Metadata: &cloudruntime_client.Metadata{
+										Name: lo.ToPtr(regionName),
+									},
+								}, nil).
+								AnyTimes()
+
+							return c, nil
+						}),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "catalyst_project" "test" {
+  region = %q
+  name = %q
+}
+`, regionName, projectName),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`Invalid Region`),
+				},
+			},
+		})
+}
+
+func TestMockProjectResourceDeletionProtection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccProjectResourceConfigWithDeletionProtection(projectName, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_project.test", "deletion_protection", "true"),
+					),
+				},
+				{
+					Config:      testAccProjectResourceConfigWithDeletionProtection(projectName, true),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Deletion Protection Enabled`),
+				},
+				// lift the protection so the project can be destroyed
+				{
+					Config: testAccProjectResourceConfigWithDeletionProtection(projectName, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_project.test", "deletion_protection", "false"),
+					),
+				},
+			},
+		})
+}
+
+func TestMockProjectResourceAdoptExisting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var (
+		adopted bool
+		deleted bool
+	)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(
+						func(endpoint, apiKey string) (catalyst.Client, error) {
+							c := catalyst.NewMockClient(ctrl)
+
+							c.EXPECT().
+								GetUserOrg(gomock.Any()).
+								Return(&conductor_client.Organization{
+									Data: conductor_client.OrganizationData{
+										Id: lo.ToPtr(orgID),
+									},
+								}, nil).
+								AnyTimes()
+
+							c.EXPECT().
+								GetRegion(gomock.Any(), gomock.Any()).
+								Return(&cloudruntime_client.Region{
+									Kind: lo.ToPtr(catalyst.KindRegion),
+									Status: &cloudruntime_client.RegionStatus{
+										Status: lo.ToPtr("ready"),
+									},
+								}, nil).
+								AnyTimes()
+
+							// the project already exists
+							c.EXPECT().
+								CreateProject(gomock.Any(), gomock.Any()).
+								Return(&catalyst.APIError{
+									StatusCode: http.StatusConflict,
+									Err:        diagrid_errors.NewDiagridCloudError(http.StatusConflict),
+								}).
+								AnyTimes()
+
+							c.EXPECT().
+								GetProject(gomock.Any(), gomock.Any(), gomock.Any()).
+								DoAndReturn(func(_ context.Context, name string, _ *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+									if deleted {
+										return nil, diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+									}
+
+									// the existing project lives in another region until adopted
+									projectRegion := "other-region"
+									if adopted {
+										projectRegion = regionName
+									}
+
+									return &cloudruntime_client.Project{
+										Kind: lo.ToPtr(catalyst.KindProject),
+										Metadata: &cloudruntime_client.Metadata{
+											Name: lo.ToPtr(name),
+										},
+										Spec: &cloudruntime_client.ProjectSpec{
+											Region: lo.ToPtr(projectRegion),
+										},
+										Status: &cloudruntime_client.ProjectStatus{
+											Status: lo.ToPtr("ready"),
+											Endpoints: &cloudruntime_client.ProjectStatusEndpoint{
+												Grpc: &cloudruntime_client.ProjectStatusEndpointDetails{
+													Url: lo.ToPtr(fmt.Sprintf("grpc://grpc-%s.%s", name, regionIngress)),
+												},
+												Http: &cloudruntime_client.ProjectStatusEndpointDetails{
+													Url: lo.ToPtr(fmt.Sprintf("http://http-%s.%s", name, regionIngress)),
+												},
+											},
+										},
+									}, nil
+								}).
+								AnyTimes()
+
+							c.EXPECT().
+								ListProjects(gomock.Any()).
+								DoAndReturn(func(context.Context) ([]cloudruntime_client.Project, error) {
+									return []cloudruntime_client.Project{{
+										Metadata: &cloudruntime_client.Metadata{
+											Name: lo.ToPtr(projectName),
+										},
+										Spec: &cloudruntime_client.ProjectSpec{
+											Region: lo.ToPtr(regionName),
+										},
+									}}, nil
+								}).
+								AnyTimes()
+
+							c.EXPECT().
+								UpdateProject(gomock.Any(), gomock.Any()).
+								DoAndReturn(func(_ context.Context, project *cloudruntime_client.Project) error {
+									if *project.Spec.Region != regionName {
+										return fmt.Errorf("unexpected region %s", *project.Spec.Region)
+									}
+									adopted = true
+									return nil
+								}).
+								AnyTimes()
+
+							c.EXPECT().
+								DeleteProject(gomock.Any(), gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ string) error {
+									deleted = true
+									return nil
+								}).
+								AnyTimes()
+
+							return c, nil
+						}),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "catalyst_project" "test" {
+  region = %q
+  name = %q
+  adopt_existing = true
+}
+`, regionName, projectName),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_project.test", "name", projectName),
+						resource.TestCheckResourceAttr("catalyst_project.test", "region", regionName),
+						resource.TestCheckResourceAttr("catalyst_project.test", "adopt_existing", "true"),
+						func(*terraform.State) error {
+							if !adopted {
+								return fmt.Errorf("existing project was not adopted")
+							}
+							return nil
+						},
+					),
+				},
+			},
+		})
+}
+
+func TestMockProjectResourceAdoptOnlyConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var creates int
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(
+						func(endpoint, apiKey string) (catalyst.Client, error) {
+							c := catalyst.NewMockClient(ctrl)
+
+							c.EXPECT().
+								GetUserOrg(gomock.Any()).
+								Return(&conductor_client.Organization{
+									Data: conductor_client.OrganizationData{
+										Id: lo.ToPtr(orgID),
+									},
+								}, nil).
+								AnyTimes()
+
+							c.EXPECT().
+								GetRegion(gomock.Any(), gomock.Any()).
+								Return(&cloudruntime_client.Region{
+									Kind: lo.ToPtr(catalyst.KindRegion),
+									Status: &cloudruntime_client.RegionStatus{
+										Status: lo.ToPtr("ready"),
+									},
+								}, nil).
+								AnyTimes()
+
+							// the create may have landed, or the project may
+							// belong to someone else, so it isn't adopted
+							c.EXPECT().
+								CreateProject(gomock.Any(), gomock.Any()).
+								DoAndReturn(func(context.Context, *cloudruntime_client.Project) error {
+									creates++
+									return &catalyst.APIError{
+										StatusCode: http.StatusInternalServerError,
+										Err:        diagrid_errors.NewDiagridCloudError(http.StatusInternalServerError),
+									}
+								}).
+								AnyTimes()
+
+							c.EXPECT().
+								GetProject(gomock.Any(), gomock.Any(), gomock.Any()).
+								Times(0)
+
+							c.EXPECT().
+								UpdateProject(gomock.Any(), gomock.Any()).
+								Times(0)
+
+							return c, nil
+						}),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "catalyst_project" "test" {
+  region = %q
+  name = %q
+  adopt_existing = true
+}
+`, regionName, projectName),
+					ExpectError: regexp.MustCompile(`Error creating project`),
+				},
+			},
+		})
+
+	if creates != 1 {
+		t.Errorf("expected the project to be created once, got %d", creates)
+	}
+}
+
 func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.ClientFactory {
 	return func(endpoint, apiKey string) (catalyst.Client, error) {
 		c := catalyst.NewMockClient(ctrl)
@@ -136,6 +519,16 @@ func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.C
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			ListRegions(gomock.Any()).
+			DoAndReturn(func(_ context.Context) ([]cloudruntime_client.Region, error) {
+				if region == nil {
+					return nil, nil
+				}
+				return []cloudruntime_client.Region{*region}, nil
+			}).
+			AnyTimes()
+
 		c.EXPECT().
 			DeleteRegion(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, name string) error {
@@ -169,7 +562,7 @@ func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.C
 					Kind:       lo.ToPtr(catalyst.KindProject),
 					Metadata: &cloudruntime_client.Metadata{
 						Uid:  lo.ToPtr(strconv.FormatInt(rand.Int63(), 10)),
-						Name: lo.ToPtr(projectName),
+						Name: lo.ToPtr(name),
 					},
 					Spec: &cloudruntime_client.ProjectSpec{
 						Region: lo.ToPtr(regionName),
@@ -189,6 +582,31 @@ func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.C
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			ListProjects(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) ([]cloudruntime_client.Project, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				var projects []cloudruntime_client.Project
+				for name := range projs {
+					projects = append(projects, cloudruntime_client.Project{
+						Metadata: &cloudruntime_client.Metadata{
+							Name: lo.ToPtr(name),
+						},
+						Spec: &cloudruntime_client.ProjectSpec{
+							Region: lo.ToPtr(regionName),
+						},
+					})
+				}
+				return projects, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			UpdateProject(gomock.Any(), gomock.Any()).
+			Return(nil).
+			AnyTimes()
+
 		c.EXPECT().
 			DeleteProject(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, name string) error {
@@ -220,3 +638,21 @@ resource "catalyst_project" "test" {
 }
 `, regionName, regionIngress, regionHost, regionLocation, name)
 }
+
+func testAccProjectResourceConfigWithDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "catalyst_region" "test" {
+  name = %q
+  ingress = %q
+  host = %q
+  location = %q
+}
+
+resource "catalyst_project" "test" {
+  region = catalyst_region.test.name
+  name = %q
+  wait_for_ready = false
+  deletion_protection = %t
+}
+`, regionName, regionIngress, regionHost, regionLocation, name, deletionProtection)
+}
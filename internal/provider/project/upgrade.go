@@ -0,0 +1,68 @@
+package project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// schemaVersion is the version of the project resource schema. Bump it and
+// add an upgrader whenever existing state can no longer be read as is.
+const schemaVersion = 1
+
+// modelV0 describes projects stored before the schema was versioned.
+type modelV0 struct {
+	Name               types.String `tfsdk:"name"`
+	Region             types.String `tfsdk:"region"`
+	GRPCEndpoint       types.String `tfsdk:"grpc_endpoint"`
+	HTTPEndpoint       types.String `tfsdk:"http_endpoint"`
+	WaitForReady       types.Bool   `tfsdk:"wait_for_ready"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// schemaV0 is the schema of projects stored before the schema was
+// versioned. Attributes added without a version bump are optional, so state
+// written by any of those releases can be read.
+func schemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":                schema.StringAttribute{Required: true},
+			"region":              schema.StringAttribute{Optional: true},
+			"grpc_endpoint":       schema.StringAttribute{Optional: true, Computed: true},
+			"http_endpoint":       schema.StringAttribute{Optional: true, Computed: true},
+			"wait_for_ready":      schema.BoolAttribute{Optional: true, Computed: true},
+			"adopt_existing":      schema.BoolAttribute{Optional: true, Computed: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// upgradeStateV0 fills in the defaults of attributes missing from
+// unversioned state, so upgrading the provider doesn't plan changes to them.
+func upgradeStateV0(ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior modelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := NewResourceModel()
+	model.Name = prior.Name
+	model.Region = prior.Region
+	model.GRPCEndpoint = customtypes.URL{StringValue: prior.GRPCEndpoint}
+	model.HTTPEndpoint = customtypes.URL{StringValue: prior.HTTPEndpoint}
+	model.WaitForReady = helpers.BoolWithDefault(prior.WaitForReady, true)
+	model.AdoptExisting = helpers.BoolWithDefault(prior.AdoptExisting, false)
+	model.DeletionProtection = helpers.BoolWithDefault(prior.DeletionProtection, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,14 @@
+package project_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/project"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/stateupgrade"
+)
+
+func TestProjectResourceUpgradeState(t *testing.T) {
+	stateupgrade.Replay(t, project.NewResource().(resource.ResourceWithUpgradeState), "testdata/state")
+}
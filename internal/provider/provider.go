@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,8 +19,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apikey"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/appid"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/organization"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/project"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/region"
 )
@@ -22,6 +31,8 @@ import (
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &catalystProvider{}
 var _ provider.ProviderWithFunctions = &catalystProvider{}
+var _ provider.ProviderWithListResources = &catalystProvider{}
+var _ provider.ProviderWithEphemeralResources = &catalystProvider{}
 
 var (
 	// ProdAPIEndpoint is the Base URL for Catalyst Production API endpoint
@@ -54,6 +65,8 @@ type catalystProvider struct {
 type catalystModel struct {
 	Endpoint types.String `tfsdk:"endpoint"`
 	APIKey   types.String `tfsdk:"api_key"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+	CacheTTL types.String `tfsdk:"cache_ttl"`
 }
 
 func New(version string) Provider {
@@ -95,6 +108,16 @@ func (p *catalystProvider) Schema(ctx context.Context,
 				Optional:            true,
 				MarkdownDescription: "Endpoint is the URL of Catalyst. Alternatively, this can also be specified using the `CATALYST_API_ENDPOINT` environment variable.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When true, every create, update and delete of a resource fails before reaching Catalyst, while reads and data sources keep working. " +
+					"Alternatively, this can also be specified using the `CATALYST_READ_ONLY` environment variable, which this setting can't lift once true. Defaults to `false`.",
+			},
+			"cache_ttl": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long reads of the Catalyst API are cached and shared between the resources and data sources of a run, as a duration such as `10s`. " +
+					"`0s` disables the cache. Alternatively, this can also be specified using the `CATALYST_CACHE_TTL` environment variable. Defaults to `5s`.",
+			},
 		},
 	}
 
@@ -113,6 +136,31 @@ func (p *catalystProvider) Configure(ctx context.Context,
 		// default to prod endpoint
 		endpoint = ProdAPIEndpoint
 	}
+	readOnly := false
+	if v, ok := os.LookupEnv("CATALYST_READ_ONLY"); ok && v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid CATALYST_READ_ONLY",
+				fmt.Sprintf("CATALYST_READ_ONLY must be a boolean such as true or false, got %q.", v),
+			)
+			return
+		}
+		readOnly = parsed
+	}
+
+	cacheTTL := catalyst.DefaultCacheTTL
+	if v, ok := os.LookupEnv("CATALYST_CACHE_TTL"); ok && v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid CATALYST_CACHE_TTL",
+				fmt.Sprintf("CATALYST_CACHE_TTL must be a duration such as 10s or 0s, got %q.", v),
+			)
+			return
+		}
+		cacheTTL = parsed
+	}
 
 	var model catalystModel
 
@@ -132,6 +180,31 @@ func (p *catalystProvider) Configure(ctx context.Context,
 	if model.Endpoint.ValueString() != "" {
 		endpoint = model.Endpoint.ValueString()
 	}
+	// the environment can enforce the read-only mode, which the
+	// configuration can enable but not lift
+	if !model.ReadOnly.IsNull() && !model.ReadOnly.IsUnknown() {
+		if readOnly && !model.ReadOnly.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("read_only"),
+				"Read-Only Mode Enforced",
+				"CATALYST_READ_ONLY is true, so the provider is read-only even though read_only is false. "+
+					"Unset CATALYST_READ_ONLY to create, update or delete resources.",
+			)
+		}
+		readOnly = readOnly || model.ReadOnly.ValueBool()
+	}
+	if v := model.CacheTTL.ValueString(); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cache_ttl"),
+				"Invalid Cache TTL",
+				fmt.Sprintf("cache_ttl must be a duration such as 10s or 0s, got %q.", v),
+			)
+			return
+		}
+		cacheTTL = parsed
+	}
 
 	c, err := p.clientFactory(endpoint, apiKey)
 	if err != nil {
@@ -142,18 +215,47 @@ func (p *catalystProvider) Configure(ctx context.Context,
 		return
 	}
 
+	if cacheTTL > 0 {
+		c = catalyst.NewCachingClient(c, cacheTTL)
+	}
+
+	if readOnly {
+		tflog.Info(ctx, "provider is read-only, resources can't be created, updated or deleted")
+	}
+
 	providerData := data.ProviderData{
-		Client: c,
+		Client:   c,
+		ReadOnly: readOnly,
+		Projects: poller.Projects(c),
+		Regions:  poller.Regions(c),
 	}
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 func (p *catalystProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		project.NewResource,
 		region.NewResource,
+		apikey.NewResource,
+	}
+}
+
+func (p *catalystProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		apikey.NewListResource,
+		project.NewListResource,
+		region.NewListResource,
+	}
+}
+
+func (p *catalystProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		appid.NewAPITokenEphemeralResource,
+		region.NewJoinTokenEphemeralResource,
 	}
 }
 
@@ -11,6 +11,8 @@ import (
 	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 )
 
@@ -47,10 +49,12 @@ func (d *dataSource) Schema(ctx context.Context,
 				Required:            true,
 			},
 			"host": schema.StringAttribute{
+				CustomType:          customtypes.HostType{},
 				MarkdownDescription: "Region host",
 				Optional:            true,
 			},
 			"ingress": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "Region ingress",
 				Optional:            true,
 			},
@@ -71,6 +75,30 @@ func (d *dataSource) Schema(ctx context.Context,
 				MarkdownDescription: "Whether the region is connected",
 				Computed:            true,
 			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters joined to the region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Cluster name",
+							Computed:            true,
+						},
+						"agent_version": schema.StringAttribute{
+							MarkdownDescription: "Version of the agent running in the cluster",
+							Computed:            true,
+						},
+						"last_heartbeat": schema.StringAttribute{
+							MarkdownDescription: "Time of the last heartbeat received from the cluster, in RFC 3339 format",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							MarkdownDescription: "Cluster health",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -122,8 +150,7 @@ func (d *dataSource) Read(ctx context.Context,
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading project datasource: %s", err))
+		apierrors.AddError(&resp.Diagnostics, err, "error reading region datasource")
 		return
 	}
 
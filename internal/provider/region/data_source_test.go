@@ -3,9 +3,11 @@ package region_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/samber/lo"
 	"go.uber.org/mock/gomock"
@@ -16,6 +18,13 @@ import (
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
 )
 
+var (
+	clusterName          = acctest.RandomWithPrefix("cluster")
+	clusterAgentVersion  = "v0.1.0"
+	clusterLastHeartbeat = time.Date(2025, time.August, 25, 10, 33, 47, 0, time.UTC)
+	clusterHealth        = "healthy"
+)
+
 func TestMockRegionDataSource(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -37,6 +46,11 @@ func TestMockRegionDataSource(t *testing.T) {
 						resource.TestCheckResourceAttr("data.catalyst_region.test", "location", regionLocation),
 						resource.TestCheckResourceAttr("data.catalyst_region.test", "type", regionType),
 						resource.TestCheckResourceAttr("data.catalyst_region.test", "connected", "true"),
+						resource.TestCheckResourceAttr("data.catalyst_region.test", "clusters.#", "1"),
+						resource.TestCheckResourceAttr("data.catalyst_region.test", "clusters.0.name", clusterName),
+						resource.TestCheckResourceAttr("data.catalyst_region.test", "clusters.0.agent_version", clusterAgentVersion),
+						resource.TestCheckResourceAttr("data.catalyst_region.test", "clusters.0.last_heartbeat", clusterLastHeartbeat.Format(time.RFC3339)),
+						resource.TestCheckResourceAttr("data.catalyst_region.test", "clusters.0.health", clusterHealth),
 					),
 				},
 			},
@@ -61,6 +75,14 @@ func mockDatasourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider
 						Ingress:  lo.ToPtr(regionIngress),
 						Location: lo.ToPtr(regionLocation),
 						Type:     lo.ToPtr(regionType),
+						Clusters: &[]client.RegionCluster{
+							{
+								Name:          lo.ToPtr(clusterName),
+								AgentVersion:  lo.ToPtr(clusterAgentVersion),
+								LastHeartbeat: lo.ToPtr(clusterLastHeartbeat),
+								Health:        lo.ToPtr(clusterHealth),
+							},
+						},
 					},
 					Status: &client.RegionStatus{
 						Connected: lo.ToPtr(true),
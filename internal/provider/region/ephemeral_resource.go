@@ -0,0 +1,118 @@
+package region
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &joinTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &joinTokenEphemeralResource{}
+
+// joinTokenEphemeralResource fetches the join token of a private region
+// without it ever being written to state or plan.
+type joinTokenEphemeralResource struct {
+	client catalyst.Client
+}
+
+// joinTokenModel describes the ephemeral resource data model.
+type joinTokenModel struct {
+	Region    types.String `tfsdk:"region"`
+	JoinToken types.String `tfsdk:"join_token"`
+}
+
+func NewJoinTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &joinTokenEphemeralResource{}
+}
+
+func (e *joinTokenEphemeralResource) Metadata(ctx context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_region_join_token"
+}
+
+func (e *joinTokenEphemeralResource) Schema(ctx context.Context,
+	req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Join token of a Catalyst private region. " +
+			"The token is fetched at apply time and never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region name",
+				Required:            true,
+			},
+			"join_token": schema.StringAttribute{
+				MarkdownDescription: "Join token for the region",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *joinTokenEphemeralResource) Configure(ctx context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = providerData.Client
+}
+
+func (e *joinTokenEphemeralResource) Open(ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var model joinTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := model.Region.ValueString()
+	joinToken, err := e.client.GetRegionJoinToken(ctx, name)
+	if err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			resp.Diagnostics.AddError("Region Not Found",
+				fmt.Sprintf("Region %q does not exist", name))
+			return
+		}
+
+		apierrors.AddError(&resp.Diagnostics, err, "Error getting join token")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched region join token", map[string]interface{}{
+		"region": name,
+	})
+
+	model.JoinToken = types.StringValue(joinToken)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+}
@@ -0,0 +1,68 @@
+package region_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+)
+
+func TestMockRegionJoinTokenEphemeralResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, map[string]bool{})),
+				),
+				"echo": echoprovider.NewProviderServer(),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      testAccRegionJoinTokenConfig(`"missing"`),
+					ExpectError: regexp.MustCompile(`Region Not Found`),
+				},
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("catalyst_region.test", "join_token"),
+					),
+				},
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, false) +
+						testAccRegionJoinTokenConfig("catalyst_region.test.name"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("echo.test", "data", regionJoinToken),
+						resource.TestCheckNoResourceAttr("catalyst_region.test", "join_token"),
+					),
+				},
+			},
+		})
+}
+
+func testAccRegionJoinTokenConfig(region string) string {
+	return fmt.Sprintf(`
+ephemeral "catalyst_region_join_token" "test" {
+  region = %s
+}
+
+provider "echo" {
+  data = ephemeral.catalyst_region_join_token.test.join_token
+}
+
+resource "echo" "test" {}
+`, region)
+}
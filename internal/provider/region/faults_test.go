@@ -0,0 +1,193 @@
+package region_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+)
+
+func TestFakeAPIRegionResourceWaitsForReady(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(2))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+					Check: func(_ *terraform.State) error {
+						// the region list is polled until the region leaves
+						// processing
+						lists := api.Requests(http.MethodGet, "/regions") - api.Requests(http.MethodGet, "/regions/")
+						if lists < 3 {
+							return fmt.Errorf("expected the regions to be listed at least 3 times, got %d", lists)
+						}
+						return nil
+					},
+				},
+			},
+		})
+}
+
+func TestFakeAPIRegionResourceCreateThrottled(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				// the throttled request is retried once the API asks to
+				{
+					PreConfig: func() {
+						api.Inject(fakeapi.Fault{
+							Method:     http.MethodPost,
+							Path:       "/regions",
+							Status:     http.StatusTooManyRequests,
+							Times:      1,
+							RetryAfter: time.Second,
+						})
+					},
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "name", regionName),
+						func(_ *terraform.State) error {
+							if creates := api.Requests(http.MethodPost, "/regions"); creates != 2 {
+								return fmt.Errorf("expected the region to be created with 2 requests, got %d", creates)
+							}
+							return nil
+						},
+					),
+				},
+			},
+		})
+}
+
+func TestFakeAPIRegionResourceCreateTimeout(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						api.StickRegion(regionName, "processing")
+					},
+					Config:      testAccRegionResourceConfigWithTimeouts(regionName, "3s", "1m"),
+					ExpectError: regexp.MustCompile(`context deadline exceeded`),
+				},
+				// the region that never became ready is tainted and replaced
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccRegionResourceConfigWithTimeouts(regionName, "3s", "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_region.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestFakeAPIRegionResourceDeleteTimeout(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "3s"),
+				},
+				// the region stays in deleting, so the delete never completes
+				{
+					PreConfig: func() {
+						api.StickRegion(regionName, "deleting")
+					},
+					Config:      testAccRegionResourceConfigWithTimeouts(regionName, "1m", "3s"),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`context deadline exceeded`),
+				},
+				// the region is kept in state, and found gone once the
+				// deletion completes
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccRegionResourceConfigWithTimeouts(regionName, "1m", "3s"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_region.test", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestFakeAPIRegionResourceNotFound(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+				},
+				// a region deleted outside of Terraform is created again
+				{
+					PreConfig: func() {
+						api.DropRegion(regionName)
+					},
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_region.test", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+				// a region lost right after being accepted fails the create
+				{
+					PreConfig: func() {
+						api.DropRegion(regionName)
+						api.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "/regions", Drop: true})
+					},
+					Config:      testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+					ExpectError: regexp.MustCompile(`Error getting region`),
+				},
+				{
+					PreConfig: api.ClearFaults,
+					Config:    testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_region.test", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+}
+
+func testAccRegionResourceConfigWithTimeouts(name, create, delete string) string {
+	return fmt.Sprintf(`
+resource "catalyst_region" "test" {
+  name = %q
+  ingress = %q
+  host = %q
+  location = %q
+
+  timeouts {
+    create = %q
+    delete = %q
+  }
+}
+`, name, regionIngress, regionHost, regionLocation, create, delete)
+}
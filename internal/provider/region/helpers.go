@@ -4,8 +4,14 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
 )
 
 func read(ctx context.Context,
@@ -22,32 +28,68 @@ func read(ctx context.Context,
 		return fmt.Errorf("error getting region: %w", err)
 	}
 
+	m.fromRegion(region)
+
 	tflog.Debug(ctx, "read region",
 		map[string]interface{}{
-			"name":       *region.Metadata.Name,
-			"type":       *region.Spec.Type,
-			"ingress":    *region.Spec.Ingress,
-			"host":       *region.Spec.Host,
-			"location":   *region.Spec.Location,
-			"connected?": region.Status.Connected != nil && *region.Status.Connected,
+			"model": m.String(),
 		})
 
-	m.SetName(*region.Metadata.Name)
-	m.SetIngress(*region.Spec.Ingress)
-	m.SetType(*region.Spec.Type)
-	if region.Spec.Host != nil &&
-		*region.Spec.Host != "" {
-		m.SetHost(*region.Spec.Host)
+	return nil
+
+}
+
+// projectsInRegion returns the names of the projects that live in the region.
+func projectsInRegion(ctx context.Context,
+	client catalyst.Client,
+	name string,
+) ([]string, error) {
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if region.Spec.Location != nil &&
-		*region.Spec.Location != "" {
-		m.SetLocation(*region.Spec.Location)
+
+	var names []string
+	for _, project := range projects {
+		if project.Metadata == nil ||
+			project.Metadata.Name == nil ||
+			project.Spec == nil ||
+			lo.FromPtr(project.Spec.Region) != name {
+			continue
+		}
+		names = append(names, *project.Metadata.Name)
 	}
-	m.SetConnected(false)
-	if region.Status.Connected != nil {
-		m.SetConnected(*region.Status.Connected)
+
+	return names, nil
+}
+
+// deleteProject deletes a project and waits until it is gone.
+func deleteProject(ctx context.Context,
+	client catalyst.Client,
+	name string,
+) error {
+	tflog.Debug(ctx, "deleting project in region",
+		map[string]interface{}{
+			"name": name,
+		})
+
+	if err := client.DeleteProject(ctx, name); err != nil {
+		if diagrid_errors.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return err
 	}
 
-	return nil
+	return helpers.WaitFor(ctx, "project "+name+" to be deleted", func(ctx context.Context) (bool, string, error) {
+		project, err := client.GetProject(catalyst.Fresh(ctx), name, &cloudruntime_client.DescribeProjectParams{})
+		if err != nil {
+			if diagrid_errors.IsResourceNotFoundError(err) {
+				return true, "", nil
+			}
+
+			return false, "", fmt.Errorf("error checking for deleted project %s: %w", name, err)
+		}
 
+		return false, lo.FromPtr(lo.FromPtr(project.Status).Status), nil
+	})
 }
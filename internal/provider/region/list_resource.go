@@ -0,0 +1,166 @@
+package region
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &regionListResource{}
+var _ list.ListResourceWithConfigure = &regionListResource{}
+
+// regionListResource enumerates the regions of the organization.
+type regionListResource struct {
+	client catalyst.Client
+}
+
+// listModel describes the filters of the list resource.
+type listModel struct {
+	Type       types.String `tfsdk:"type"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func NewListResource() list.ListResource {
+	return &regionListResource{}
+}
+
+func (p *regionListResource) Metadata(ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+func (p *regionListResource) ListResourceConfigSchema(ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Catalyst regions of the organization",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list regions of this type, `public` or `private`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalyst.RegionTypePublic, catalyst.RegionTypePrivate),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list regions whose name starts with this prefix",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *regionListResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(data.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected data.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	p.client = providerData.Client
+}
+
+func (p *regionListResource) List(ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter listModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	orgID, err := helpers.OrganizationID(ctx, p.client)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error getting organization")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	regions, err := p.client.ListRegions(ctx)
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error listing regions")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "listed regions", map[string]interface{}{
+		"count":       len(regions),
+		"type":        filter.Type.ValueString(),
+		"name_prefix": filter.NamePrefix.ValueString(),
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, region := range regions {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			name := lo.FromPtr(lo.FromPtr(region.Metadata).Name)
+			if name == "" ||
+				!strings.HasPrefix(name, filter.NamePrefix.ValueString()) {
+				continue
+			}
+
+			model := NewResourceModel()
+			model.SetName(name)
+			model.fromRegion(&region)
+			if !filter.Type.IsNull() &&
+				model.GetType() != filter.Type.ValueString() {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(result.Identity.Set(ctx, helpers.Identity{
+				OrganizationID: types.StringValue(orgID),
+				Name:           types.StringValue(name),
+			})...)
+
+			if req.IncludeResource {
+				model.SetAdoptExisting(false)
+				model.SetDeletionProtection(false)
+				model.SetForceDestroy(false)
+				model.SetStoreJoinToken(true)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
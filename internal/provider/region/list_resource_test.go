@@ -0,0 +1,173 @@
+package region_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/samber/lo"
+	"go.uber.org/mock/gomock"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+	catalyst_region "github.com/diagridio/terraform-provider-catalyst/internal/provider/region"
+)
+
+func TestMockRegionListResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := catalyst.NewMockClient(ctrl)
+
+	c.EXPECT().
+		GetUserOrg(gomock.Any()).
+		Return(&conductor_client.Organization{
+			Data: conductor_client.OrganizationData{
+				Id: lo.ToPtr(orgID),
+			},
+		}, nil).
+		AnyTimes()
+
+	c.EXPECT().
+		ListRegions(gomock.Any()).
+		Return([]cloudruntime_client.Region{
+			newListedRegion("region-a", catalyst.RegionTypePrivate),
+			newListedRegion("region-b", catalyst.RegionTypePublic),
+			newListedRegion("other", catalyst.RegionTypePrivate),
+			// regions without a name can't be addressed and are skipped
+			{},
+		}, nil).
+		AnyTimes()
+
+	tests := []struct {
+		name       string
+		regionType *string
+		namePrefix *string
+		limit      int64
+		expected   []string
+	}{
+		{
+			name:     "all",
+			expected: []string{"region-a", "region-b", "other"},
+		},
+		{
+			name:       "type",
+			regionType: lo.ToPtr(catalyst.RegionTypePrivate),
+			expected:   []string{"region-a", "other"},
+		},
+		{
+			name:       "name prefix",
+			namePrefix: lo.ToPtr("region-"),
+			expected:   []string{"region-a", "region-b"},
+		},
+		{
+			name:       "type and name prefix",
+			regionType: lo.ToPtr(catalyst.RegionTypePrivate),
+			namePrefix: lo.ToPtr("region-"),
+			expected:   []string{"region-a"},
+		},
+		{
+			name:     "limit",
+			limit:    1,
+			expected: []string{"region-a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := listRegions(t, c, map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, tt.regionType),
+				"name_prefix": tftypes.NewValue(tftypes.String, tt.namePrefix),
+			}, tt.limit)
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("expected %d results, got %d", len(tt.expected), len(results))
+			}
+
+			for i, result := range results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+				if result.DisplayName != tt.expected[i] {
+					t.Errorf("expected %s, got %s", tt.expected[i], result.DisplayName)
+				}
+
+				var identity helpers.Identity
+				result.Identity.Get(context.Background(), &identity)
+				if identity.OrganizationID.ValueString() != orgID ||
+					identity.Name.ValueString() != tt.expected[i] {
+					t.Errorf("unexpected identity %v", identity)
+				}
+
+				var regionType types.String
+				result.Resource.GetAttribute(context.Background(), path.Root("type"), &regionType)
+				if regionType.IsNull() {
+					t.Errorf("expected the resource of %s to be included", result.DisplayName)
+				}
+			}
+		})
+	}
+}
+
+func newListedRegion(name, regionType string) cloudruntime_client.Region {
+	return cloudruntime_client.Region{
+		Kind: lo.ToPtr(catalyst.KindRegion),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.RegionSpec{
+			Type: lo.ToPtr(regionType),
+		},
+	}
+}
+
+// listRegions runs the list resource against the client, including the
+// resource in each result.
+func listRegions(t *testing.T,
+	c catalyst.Client,
+	config map[string]tftypes.Value,
+	limit int64,
+) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	lr := catalyst_region.NewListResource().(list.ListResourceWithConfigure)
+	lr.Configure(ctx, resource.ConfigureRequest{
+		ProviderData: data.ProviderData{Client: c},
+	}, &resource.ConfigureResponse{})
+
+	var listSchema list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchema)
+
+	r := catalyst_region.NewResource().(resource.ResourceWithIdentity)
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: listSchema.Schema,
+			Raw:    tftypes.NewValue(listSchema.Schema.Type().TerraformType(ctx), config),
+		},
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,83 @@
+package region
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// attributes maps the fields of the API object to the attributes they are
+// set from.
+var attributes = map[string]path.Path{
+	"metadata.name": path.Root("name"),
+	"spec.host":     path.Root("host"),
+	"spec.ingress":  path.Root("ingress"),
+	"spec.location": path.Root("location"),
+}
+
+// toRegion builds the API object for the model.
+func (m *model) toRegion() *client.Region {
+	return &client.Region{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindRegion),
+		Metadata: &client.Metadata{
+			Name: lo.ToPtr(m.GetName()),
+		},
+		Spec: &client.RegionSpec{
+			Host:     helpers.StringPointer(m.Host.StringValue),
+			Ingress:  helpers.StringPointer(m.Ingress.StringValue),
+			Location: helpers.StringPointer(m.Location),
+		},
+		Status: &client.RegionStatus{},
+	}
+}
+
+// fromRegion updates the model with the API object. Any part of the object
+// may be missing, in which case the attributes it holds are left empty.
+func (m *model) fromRegion(region *client.Region) {
+	if region == nil {
+		region = &client.Region{}
+	}
+	metadata := lo.FromPtr(region.Metadata)
+	spec := lo.FromPtr(region.Spec)
+	status := lo.FromPtr(region.Status)
+
+	if metadata.Name != nil {
+		m.SetName(*metadata.Name)
+	}
+	m.Ingress = customtypes.URL{StringValue: helpers.StringFromAPI(m.Ingress.StringValue, spec.Ingress)}
+	m.Host = customtypes.Host{StringValue: helpers.StringFromAPI(m.Host.StringValue, spec.Host)}
+	m.Location = helpers.StringFromAPI(m.Location, spec.Location)
+	m.Type = helpers.StringFromAPI(m.Type, spec.Type)
+	m.SetConnected(lo.FromPtr(status.Connected))
+
+	var clusters []cluster
+	for _, c := range lo.FromPtr(spec.Clusters) {
+		joined := cluster{
+			Name:         lo.FromPtr(c.Name),
+			AgentVersion: lo.FromPtr(c.AgentVersion),
+			Health:       lo.FromPtr(c.Health),
+		}
+		if c.LastHeartbeat != nil {
+			joined.LastHeartbeat = c.LastHeartbeat.UTC().Format(time.RFC3339)
+		}
+		clusters = append(clusters, joined)
+	}
+	m.SetClusters(clusters)
+}
+
+// regionStatus returns the status of the region, or an empty string when
+// the API didn't report one.
+func regionStatus(region *client.Region) string {
+	if region == nil || region.Status == nil {
+		return ""
+	}
+	return lo.FromPtr(region.Status.Status)
+}
@@ -0,0 +1,50 @@
+package region
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+)
+
+func FuzzRegionFromAPI(f *testing.F) {
+	f.Add([]byte(`null`))
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"metadata":{}}`))
+	f.Add([]byte(`{"spec":{}, "status":{}}`))
+	f.Add([]byte(`{"spec":{"clusters":[{}]}}`))
+	f.Add([]byte(`{"spec":{"clusters":[null]}}`))
+	f.Add([]byte(`{"metadata":{"name":"region"},"spec":{"host":"example.com","ingress":"https://*.example.com","location":"us","type":"private","clusters":[{"name":"cluster","agentVersion":"1.0.0","lastHeartbeat":"2025-01-01T00:00:00Z","health":"healthy"}]},"status":{"connected":true,"status":"ready"}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var region *client.Region
+		if err := json.Unmarshal(data, &region); err != nil {
+			t.Skip()
+		}
+
+		m := &model{Name: types.StringValue("region")}
+		m.fromRegion(region)
+		regionStatus(region)
+
+		if m.Name.IsNull() || m.Name.IsUnknown() {
+			t.Errorf("name: expected a value, got %s", m.Name)
+		}
+		for attr, value := range map[string]interface{ IsUnknown() bool }{
+			"host":      m.Host,
+			"ingress":   m.Ingress,
+			"location":  m.Location,
+			"type":      m.Type,
+			"connected": m.Connected,
+			"clusters":  m.Clusters,
+		} {
+			if value.IsUnknown() {
+				t.Errorf("%s: expected a known value after mapping", attr)
+			}
+		}
+
+		// whatever was read must map back without panicking
+		m.toRegion()
+	})
+}
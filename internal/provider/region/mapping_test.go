@@ -0,0 +1,55 @@
+package region
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+)
+
+func TestRegionMappingRoundTrip(t *testing.T) {
+	m := &model{
+		Name:     types.StringValue("region"),
+		Host:     customtypes.Host{StringValue: types.StringValue("host.example.com")},
+		Ingress:  customtypes.NewURLValue("https://*.example.com:443"),
+		Location: types.StringValue("location"),
+		Type:     types.StringUnknown(),
+	}
+
+	region := m.toRegion()
+	assertPointer(t, "host", lo.ToPtr("host.example.com"), region.Spec.Host)
+	assertPointer(t, "ingress", lo.ToPtr("https://*.example.com:443"), region.Spec.Ingress)
+	assertPointer(t, "location", lo.ToPtr("location"), region.Spec.Location)
+	assertPointer(t, "type", nil, region.Spec.Type)
+
+	// the API responds with what it stored, and the type it chose
+	region.Spec.Type = lo.ToPtr("private")
+	region.Status = &client.RegionStatus{Connected: lo.ToPtr(true)}
+
+	m.fromRegion(region)
+	assertString(t, "host", types.StringValue("host.example.com"), m.Host.StringValue)
+	assertString(t, "ingress", types.StringValue("https://*.example.com:443"), m.Ingress.StringValue)
+	assertString(t, "location", types.StringValue("location"), m.Location)
+	assertString(t, "type", types.StringValue("private"), m.Type)
+}
+
+func assertPointer(t *testing.T, name string, expected, actual *string) {
+	t.Helper()
+
+	if (expected == nil) != (actual == nil) ||
+		(expected != nil && *expected != *actual) {
+		t.Errorf("%s: expected %v to be sent, got %v", name, lo.FromPtr(expected), lo.FromPtr(actual))
+	}
+}
+
+func assertString(t *testing.T, name string, expected, actual types.String) {
+	t.Helper()
+
+	if !expected.Equal(actual) {
+		t.Errorf("%s: expected %s to be stored, got %s", name, expected, actual)
+	}
+}
@@ -3,24 +3,54 @@ package region
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
 )
 
+// clusterAttrTypes describes the clusters joined to a region.
+var clusterAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"agent_version":  types.StringType,
+	"last_heartbeat": types.StringType,
+	"health":         types.StringType,
+}
+
 // model describes the data source data model.
 type model struct {
-	Name      types.String `tfsdk:"name"`
-	Host      types.String `tfsdk:"host"`
-	Ingress   types.String `tfsdk:"ingress"`
-	Location  types.String `tfsdk:"location"`
-	Type      types.String `tfsdk:"type"`
-	JoinToken types.String `tfsdk:"join_token"`
-	Connected types.Bool   `tfsdk:"connected"`
+	Name      types.String     `tfsdk:"name"`
+	Host      customtypes.Host `tfsdk:"host"`
+	Ingress   customtypes.URL  `tfsdk:"ingress"`
+	Location  types.String     `tfsdk:"location"`
+	Type      types.String     `tfsdk:"type"`
+	JoinToken types.String     `tfsdk:"join_token"`
+	Connected types.Bool       `tfsdk:"connected"`
+	Clusters  types.List       `tfsdk:"clusters"`
 }
 
 func NewModel() *model {
 	return &model{}
 }
 
+// resourceModel extends the data source model with resource only attributes.
+type resourceModel struct {
+	model
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool     `tfsdk:"force_destroy"`
+	StoreJoinToken     types.Bool     `tfsdk:"store_join_token"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewResourceModel() *resourceModel {
+	return &resourceModel{
+		Timeouts: helpers.NullTimeouts(),
+	}
+}
+
 func (m *model) GetName() string {
 	return m.Name.ValueString()
 }
@@ -34,7 +64,7 @@ func (m *model) GetHost() string {
 }
 
 func (m *model) SetHost(host string) {
-	m.Host = types.StringValue(host)
+	m.Host = customtypes.NewHostValue(host)
 }
 
 func (m *model) GetIngress() string {
@@ -42,7 +72,7 @@ func (m *model) GetIngress() string {
 }
 
 func (m *model) SetIngress(ingress string) {
-	m.Ingress = types.StringValue(ingress)
+	m.Ingress = customtypes.NewURLValue(ingress)
 }
 
 func (m *model) GetLocation() string {
@@ -77,18 +107,87 @@ func (m *model) SetConnected(connected bool) {
 	m.Connected = types.BoolValue(connected)
 }
 
+// cluster describes a cluster joined to the region, empty fields are
+// stored as null.
+type cluster struct {
+	Name          string
+	AgentVersion  string
+	LastHeartbeat string
+	Health        string
+}
+
+func (m *model) SetClusters(clusters []cluster) {
+	values := make([]attr.Value, 0, len(clusters))
+	for _, c := range clusters {
+		values = append(values, types.ObjectValueMust(clusterAttrTypes,
+			map[string]attr.Value{
+				"name":           stringOrNull(c.Name),
+				"agent_version":  stringOrNull(c.AgentVersion),
+				"last_heartbeat": stringOrNull(c.LastHeartbeat),
+				"health":         stringOrNull(c.Health),
+			}))
+	}
+
+	m.Clusters = types.ListValueMust(types.ObjectType{AttrTypes: clusterAttrTypes}, values)
+}
+
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func (m *resourceModel) GetTimeouts() timeouts.Value {
+	return m.Timeouts
+}
+
+func (m *resourceModel) GetAdoptExisting() bool {
+	return m.AdoptExisting.ValueBool()
+}
+
+func (m *resourceModel) SetAdoptExisting(adopt bool) {
+	m.AdoptExisting = types.BoolValue(adopt)
+}
+
+func (m *resourceModel) GetDeletionProtection() bool {
+	return m.DeletionProtection.ValueBool()
+}
+
+func (m *resourceModel) SetDeletionProtection(protect bool) {
+	m.DeletionProtection = types.BoolValue(protect)
+}
+
+func (m *resourceModel) GetForceDestroy() bool {
+	return m.ForceDestroy.ValueBool()
+}
+
+func (m *resourceModel) SetForceDestroy(force bool) {
+	m.ForceDestroy = types.BoolValue(force)
+}
+
+func (m *resourceModel) GetStoreJoinToken() bool {
+	return m.StoreJoinToken.ValueBool()
+}
+
+func (m *resourceModel) SetStoreJoinToken(store bool) {
+	m.StoreJoinToken = types.BoolValue(store)
+}
+
 func (m *model) String() string {
 	return fmt.Sprintf(`name: %s,
 	host: %s,
 	ingress: %s,
 	location: %s,
 	type: %s,
-	connected?: %t`,
+	connected?: %t,
+	clusters: %d`,
 		m.GetName(),
 		m.GetHost(),
 		m.GetIngress(),
 		m.GetLocation(),
 		m.GetType(),
 		m.GetConnected(),
+		len(m.Clusters.Elements()),
 	)
 }
@@ -3,39 +3,82 @@ package region
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
-
-	"github.com/samber/lo"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 
 	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
 	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/apierrors"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/data"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/lifecycle"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/poller"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &regionResource{}
 var _ resource.ResourceWithImportState = &regionResource{}
+var _ resource.ResourceWithIdentity = &regionResource{}
+var _ resource.ResourceWithUpgradeState = &regionResource{}
+var _ resource.ResourceWithModifyPlan = &regionResource{}
 
 var ingressRegex = regexp.MustCompile(`^https?://\*\.[^:]+:\d+$`)
 
 // regionResource defines the resource implementation.
 type regionResource struct {
-	client catalyst.Client
+	*lifecycle.Resource[*resourceModel, client.Region]
 }
 
 func NewResource() resource.Resource {
-	return &regionResource{}
+	return &regionResource{
+		Resource: lifecycle.New(lifecycle.Definition[*resourceModel, client.Region]{
+			Kind:     "region",
+			NewModel: NewResourceModel,
+			FromAPI: func(m *resourceModel, region *client.Region) {
+				m.fromRegion(region)
+			},
+			ImportDefaults: func(m *resourceModel) {
+				m.SetAdoptExisting(false)
+				m.SetDeletionProtection(false)
+				m.SetForceDestroy(false)
+				m.SetStoreJoinToken(true)
+			},
+			Get: func(ctx context.Context, c catalyst.Client, name string) (*client.Region, error) {
+				return c.GetRegion(ctx, name)
+			},
+			Create:       createRegion,
+			Update:       updateRegion,
+			BeforeDelete: deleteProjectsInRegion,
+			Delete: func(ctx context.Context, c catalyst.Client, m *resourceModel) error {
+				return c.DeleteRegion(ctx, m.GetName())
+			},
+			Ready: func(_ *resourceModel, region *client.Region) bool {
+				return regionStatus(region) == "ready"
+			},
+			Status:     regionStatus,
+			Attributes: attributes,
+			Poller: func(providerData data.ProviderData) *poller.Poller[client.Region] {
+				return providerData.Regions
+			},
+		}),
+	}
 }
 
 func (p *regionResource) Metadata(ctx context.Context,
@@ -52,16 +95,25 @@ func (p *regionResource) Schema(ctx context.Context,
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Catalyst region resource",
+		Version:             schemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Region name",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"host": schema.StringAttribute{
+				CustomType:          customtypes.HostType{},
 				MarkdownDescription: "Region host",
 				Optional:            true,
 			},
 			"ingress": schema.StringAttribute{
+				CustomType:          customtypes.URLType{},
 				MarkdownDescription: "Region Ingress provided by user; canonicalized by API",
 				Required:            true,
 				Validators: []validator.String{
@@ -76,9 +128,10 @@ func (p *regionResource) Schema(ctx context.Context,
 				Optional:            true,
 			},
 			"join_token": schema.StringAttribute{
-				MarkdownDescription: "Join token for the region",
-				Computed:            true,
-				Sensitive:           true,
+				MarkdownDescription: "Join token for the region, only known after creation and null when `store_join_token` is false. " +
+					"Use the `catalyst_region_join_token` ephemeral resource to keep the token out of state.",
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -91,301 +144,240 @@ func (p *regionResource) Schema(ctx context.Context,
 				MarkdownDescription: "Whether the region is connected",
 				Computed:            true,
 			},
+			"clusters": schema.ListNestedAttribute{
+				MarkdownDescription: "Clusters joined to the region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Cluster name",
+							Computed:            true,
+						},
+						"agent_version": schema.StringAttribute{
+							MarkdownDescription: "Version of the agent running in the cluster",
+							Computed:            true,
+						},
+						"last_heartbeat": schema.StringAttribute{
+							MarkdownDescription: "Time of the last heartbeat received from the cluster, in RFC 3339 format",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							MarkdownDescription: "Cluster health",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing region with the same name instead of failing to create it. " +
+					"The join token of an adopted region is not available.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the region from being destroyed while set to true",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete the projects still in the region when destroying it, instead of failing",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"store_join_token": schema.BoolAttribute{
+				MarkdownDescription: "Store the join token returned on creation in `join_token`. " +
+					"Setting it to false removes a stored token from state",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 		},
 	}
 }
 
-func (p *regionResource) Configure(ctx context.Context,
-	req resource.ConfigureRequest,
-	resp *resource.ConfigureResponse,
-) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	providerData, ok := req.ProviderData.(data.ProviderData)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
+func (p *regionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   schemaV0(),
+			StateUpgrader: upgradeStateV0,
+		},
 	}
-
-	p.client = providerData.Client
 }
 
-func (p *regionResource) Create(ctx context.Context,
-	req resource.CreateRequest,
-	resp *resource.CreateResponse,
+func (p *regionResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
 ) {
-	model := NewModel()
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
+	// nothing to plan when destroying
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	tflog.Debug(ctx, "creating private region",
-		map[string]interface{}{
-			"name":     model.GetName(),
-			"host":     model.GetHost(),
-			"ingress":  model.GetIngress(),
-			"location": model.GetLocation(),
-		})
-
-	region := &client.Region{
-		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
-		Kind:       lo.ToPtr(catalyst.KindRegion),
-		Metadata: &client.Metadata{
-			Name: lo.ToPtr(model.GetName()),
-		},
-		Spec: &client.RegionSpec{
-			Host:     lo.ToPtr(model.GetHost()),
-			Ingress:  lo.ToPtr(model.GetIngress()),
-			Location: lo.ToPtr(model.GetLocation()),
-		},
-		Status: &client.RegionStatus{},
-	}
-	joinToken, err := p.client.CreateRegion(ctx, region)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error creating region: %s", err))
+	var store types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("store_join_token"), &store)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// wait until region is created and in ready status
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		region, err = p.client.GetRegion(ctx, model.GetName())
-		if err != nil {
-			return false, fmt.Errorf("Error getting region: %w", err)
-		}
-
-		if region.Status != nil &&
-			region.Status.Status != nil &&
-			*region.Status.Status == "ready" {
-			return true, nil
-		}
-
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting region: %s", err))
+	// drop the token from state when it shouldn't be stored
+	if !store.IsUnknown() && !store.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("join_token"), types.StringNull())...)
 		return
 	}
 
-	tflog.Debug(ctx, "created region",
-		map[string]interface{}{
-			"id":   region.Metadata.Uid,
-			"name": *region.Metadata.Name,
-		})
-
-	// read back into the model
-	if err := read(ctx, p.client, model); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading created region: %s", err))
+	// an update fetches the token of a private region when it should be
+	// stored but wasn't, as when store_join_token is turned on or the region
+	// was imported
+	if req.State.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
 		return
 	}
-
-	// Set the join token in the model, this is the only place we set it
-	model.SetJoinToken(joinToken)
-
-	tflog.Debug(ctx, "storing region model after creation",
-		map[string]interface{}{
-			"model": model.String(),
-		})
-
-	// Save model into Terraform state
-	diagnostics := resp.State.Set(ctx, model)
-	resp.Diagnostics.Append(diagnostics...)
+	var token, regionType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("join_token"), &token)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &regionType)...)
+	if !resp.Diagnostics.HasError() && token.IsNull() &&
+		regionType.ValueString() == catalyst.RegionTypePrivate {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("join_token"), types.StringUnknown())...)
+	}
 }
 
-func (p *regionResource) Read(ctx context.Context,
-	req resource.ReadRequest,
-	resp *resource.ReadResponse,
-) {
-	model := NewModel()
+func createRegion(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+) error {
+	joinToken, err := c.CreateRegion(ctx, m.toRegion())
+	if err != nil {
+		// only a conflict tells the region already exists, other errors may
+		// come from a region that isn't ours or from a create that landed
+		if !m.GetAdoptExisting() || apierrors.Parse(err).StatusCode != http.StatusConflict {
+			return err
+		}
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		// the region may already exist, in which case we take it over
+		if adoptErr := adopt(ctx, c, m); adoptErr != nil {
+			if !diagrid_errors.IsResourceNotFoundError(adoptErr) {
+				err = fmt.Errorf("%w; adopting existing region: %w", err, adoptErr)
+			}
+			return err
+		}
 
-	if err := read(ctx, p.client, model); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "region not found",
-				map[string]interface{}{
-					"name": model.GetName(),
-				})
+		tflog.Debug(ctx, "adopted existing region",
+			map[string]interface{}{
+				"name": m.GetName(),
+			})
 
-			resp.State.RemoveResource(ctx)
-			return
-		}
+		// the join token was fetched when updating the adopted region
+		return nil
+	}
 
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading region: %s", err))
-		return
+	// Set the join token of the created region in the model, which is only
+	// returned for private regions
+	if joinToken != "" && m.GetStoreJoinToken() {
+		m.SetJoinToken(joinToken)
+	} else {
+		m.JoinToken = types.StringNull()
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	return nil
 }
 
-func (p *regionResource) Update(ctx context.Context,
-	req resource.UpdateRequest,
-	resp *resource.UpdateResponse,
-) {
-	model := NewModel()
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	region, err := p.client.GetRegion(ctx, model.GetName())
+// adopt takes over an existing region with the same name, updating it to
+// match the planned spec.
+func adopt(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+) error {
+	existing, err := c.GetRegion(ctx, m.GetName())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting region: %s", err))
-		return
+		return err
 	}
 
+	return updateRegion(ctx, c, m, existing)
+}
+
+func updateRegion(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+	region *client.Region,
+) error {
+	if region.Spec == nil {
+		region.Spec = &client.RegionSpec{}
+	}
+	// only private regions have a join token
+	private := lo.FromPtr(region.Spec.Type) == catalyst.RegionTypePrivate
 	// scrub clusters from region, we're not allowed to update them
 	region.Spec.Clusters = nil
 	// same for region type
 	region.Spec.Type = nil
-	region.Spec.Host = lo.ToPtr(model.GetHost())
-	region.Spec.Ingress = lo.ToPtr(model.GetIngress())
-	region.Spec.Location = lo.ToPtr(model.GetLocation())
-
-	tflog.Debug(ctx, "updating region",
-		map[string]interface{}{
-			"model": model.String(),
-		})
-
-	if err := p.client.UpdateRegion(ctx, region); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error updating region: %s", err))
-		return
-	}
+	planned := m.toRegion()
+	region.Spec.Host = planned.Spec.Host
+	region.Spec.Ingress = planned.Spec.Ingress
+	region.Spec.Location = planned.Spec.Location
 
-	// wait until region is updated and in ready status
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		region, err := p.client.GetRegion(ctx, model.GetName())
-		if err != nil {
-			return false, fmt.Errorf("Error getting region: %w", err)
-		}
-
-		if region.Status != nil &&
-			region.Status.Status != nil &&
-			*region.Status.Status == "ready" {
-			return true, nil
-		}
-
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting region: %s", err))
-		return
+	if err := c.UpdateRegion(ctx, region); err != nil {
+		return err
 	}
 
-	if err := read(ctx, p.client, model); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading updated region: %s", err))
-		return
-	}
-
-	tflog.Debug(ctx, "updated region",
-		map[string]interface{}{
-			"model": model.String(),
-		})
-
-	// Save updated data into Terraform state
-	diagnostics := resp.State.Set(ctx, model)
-	resp.Diagnostics.Append(diagnostics...)
+	return fillJoinToken(ctx, c, m, private)
 }
 
-func (p *regionResource) Delete(ctx context.Context,
-	req resource.DeleteRequest,
-	resp *resource.DeleteResponse,
-) {
-	model := NewModel()
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
-	if resp.Diagnostics.HasError() {
-		return
+// fillJoinToken fetches the join token of a private region when it should
+// be stored but isn't known yet, and drops it when it shouldn't be stored.
+func fillJoinToken(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+	private bool,
+) error {
+	if !m.GetStoreJoinToken() {
+		m.JoinToken = types.StringNull()
+		return nil
 	}
-
-	tflog.Debug(ctx, "deleting region",
-		map[string]interface{}{
-			"name": model.GetName(),
-		})
-
-	if err := p.client.DeleteRegion(ctx, model.GetName()); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "region to delete not found",
-				map[string]interface{}{
-					"name": model.GetName(),
-				})
-			return
-		}
-
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error deleting region: %s", err))
-		return
+	if !m.JoinToken.IsUnknown() {
+		return nil
+	}
+	if !private {
+		m.JoinToken = types.StringNull()
+		return nil
 	}
 
-	// wait until region is gone
-	if err := helpers.WaitUntil(ctx, func(ctx context.Context) (bool, error) {
-		_, err := p.client.GetRegion(ctx, model.GetName())
-		if err != nil {
-			if diagrid_errors.IsResourceNotFoundError(err) {
-				return true, nil
-			}
-
-			return false, fmt.Errorf("error checking for deleted region: %w", err)
-		}
-
-		return false, nil
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Error getting region: %s", err))
-		return
+	joinToken, err := c.GetRegionJoinToken(ctx, m.GetName())
+	if err != nil {
+		return fmt.Errorf("getting join token: %w", err)
 	}
+	m.SetJoinToken(joinToken)
 
-	tflog.Debug(ctx, "deleted region",
-		map[string]interface{}{
-			"name": model.GetName(),
-		})
+	return nil
 }
 
-func (p *regionResource) ImportState(ctx context.Context,
-	req resource.ImportStateRequest,
-	resp *resource.ImportStateResponse,
-) {
-	model := NewModel()
-	model.SetName(req.ID)
+// deleteProjectsInRegion deletes the projects still in the region when
+// force_destroy is set, and fails otherwise.
+func deleteProjectsInRegion(ctx context.Context,
+	c catalyst.Client,
+	m *resourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	if err := read(ctx, p.client, model); err != nil {
-		if diagrid_errors.IsResourceNotFoundError(err) {
-			tflog.Debug(ctx, "region not found",
-				map[string]interface{}{
-					"name": model.GetName(),
-				})
+	projects, err := projectsInRegion(ctx, c, m.GetName())
+	if err != nil {
+		apierrors.AddError(&diags, err, "Error listing projects in region")
+		return diags
+	}
 
-			return
-		}
+	if len(projects) > 0 && !m.GetForceDestroy() {
+		diags.AddError("Region Has Projects",
+			fmt.Sprintf("Region %q still has projects: %s. "+
+				"Delete them first or set force_destroy to true.", m.GetName(), strings.Join(projects, ", ")))
+		return diags
+	}
 
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error reading imported region: %s", err))
-		return
+	for _, project := range projects {
+		if err := deleteProject(ctx, c, project); err != nil {
+			apierrors.AddError(&diags, err, fmt.Sprintf("Error deleting project %s in region", project))
+			return diags
+		}
 	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	return diags
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/samber/lo"
@@ -13,14 +14,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
 	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
 	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
 
 	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
 	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
 	"github.com/diagridio/terraform-provider-catalyst/internal/test/acceptance"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/recorder"
 )
 
 var (
@@ -30,8 +38,7 @@ var (
 	regionLocation  = "us-west-1"
 	regionType      = "public"
 	regionJoinToken = acctest.RandomWithPrefix("regionJoinToken")
-
-	region *cloudruntime_client.Region
+	orgID           = acctest.RandomWithPrefix("org")
 )
 
 func testSteps() []resource.TestStep {
@@ -46,6 +53,7 @@ func testSteps() []resource.TestStep {
 				resource.TestCheckResourceAttr("catalyst_region.test", "host", regionHost),
 				resource.TestCheckResourceAttr("catalyst_region.test", "location", regionLocation),
 				resource.TestCheckResourceAttr("catalyst_region.test", "connected", "false"),
+				resource.TestCheckResourceAttr("catalyst_region.test", "clusters.#", "0"),
 			),
 		},
 		// DataSource testing
@@ -109,11 +117,20 @@ func testSteps() []resource.TestStep {
 }
 
 func TestAccRegionResource(t *testing.T) {
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
-		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
-		Steps:                    testSteps(),
-	})
+	// replays testdata/cassettes/TestAccRegionResource.json unless recording
+	rec := recorder.New(t)
+	rec.Substitute("region_name", regionName)
+	rec.Substitute("region_host", regionHost)
+
+	rec.Test(t,
+		resource.TestCase{
+			PreCheck: func() {
+				if rec.Recording() {
+					acceptance.TestAccPreCheck(t)
+				}
+			},
+			Steps: testSteps(),
+		})
 }
 
 func TestMockRegionResource(t *testing.T) {
@@ -123,27 +140,274 @@ func TestMockRegionResource(t *testing.T) {
 		resource.TestCase{
 			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 				provider.ProviderName: providerserver.NewProtocol6WithError(
-					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl)),
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, map[string]bool{})),
 				),
 			},
 			Steps: testSteps(),
 		})
 }
 
-func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.ClientFactory {
+func TestFakeAPIRegionResource(t *testing.T) {
+	api := fakeapi.New(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps:                    testSteps(),
+		})
+}
+
+func TestFakeAPIRegionResourceRename(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+	renamed := acctest.RandomWithPrefix("region")
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithTimeouts(regionName, "1m", "1m"),
+				},
+				// the name is the key of the region, so renaming it replaces
+				// the region
+				{
+					Config: testAccRegionResourceConfigWithTimeouts(renamed, "1m", "1m"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("catalyst_region.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					Check: func(_ *terraform.State) error {
+						if _, ok := api.Region(regionName); ok {
+							return fmt.Errorf("expected region %s to be deleted", regionName)
+						}
+						if _, ok := api.Region(renamed); !ok {
+							return fmt.Errorf("expected region %s to be created", renamed)
+						}
+						return nil
+					},
+				},
+			},
+		})
+}
+
+func TestMockRegionResourceDeletionProtection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, map[string]bool{})),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithDeletionProtection(regionName, regionIngress, regionHost, regionLocation, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "deletion_protection", "true"),
+					),
+				},
+				{
+					Config:      testAccRegionResourceConfigWithDeletionProtection(regionName, regionIngress, regionHost, regionLocation, true),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Deletion Protection Enabled`),
+				},
+				// lift the protection so the region can be destroyed
+				{
+					Config: testAccRegionResourceConfigWithDeletionProtection(regionName, regionIngress, regionHost, regionLocation, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "deletion_protection", "false"),
+					),
+				},
+			},
+		})
+}
+
+func TestMockRegionResourceForceDestroy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// a project created outside of terraform lives in the region
+	projects := map[string]bool{acctest.RandomWithPrefix("prj"): true}
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, projects)),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithForceDestroy(regionName, regionIngress, regionHost, regionLocation, false),
+				},
+				{
+					Config:      testAccRegionResourceConfigWithForceDestroy(regionName, regionIngress, regionHost, regionLocation, false),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Region Has Projects`),
+				},
+				{
+					Config: testAccRegionResourceConfigWithForceDestroy(regionName, regionIngress, regionHost, regionLocation, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "force_destroy", "true"),
+					),
+				},
+				{
+					Config:  testAccRegionResourceConfigWithForceDestroy(regionName, regionIngress, regionHost, regionLocation, true),
+					Destroy: true,
+					Check: func(*terraform.State) error {
+						if len(projects) > 0 {
+							return fmt.Errorf("projects left in region: %v", projects)
+						}
+						return nil
+					},
+				},
+			},
+		})
+}
+
+func TestMockRegionResourceStoreJoinToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, map[string]bool{})),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "join_token", regionJoinToken),
+					),
+				},
+				// the stored token is dropped from state
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "store_join_token", "false"),
+						resource.TestCheckNoResourceAttr("catalyst_region.test", "join_token"),
+					),
+				},
+			},
+		})
+}
+
+func TestFakeAPIRegionResourceStoreJoinToken(t *testing.T) {
+	api := fakeapi.New(t, fakeapi.WithReadyAfter(0))
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: api.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("catalyst_region.test", "join_token"),
+					),
+				},
+				// the token is fetched once it should be stored
+				{
+					Config: testAccRegionResourceConfigWithStoreJoinToken(regionName, regionIngress, regionHost, regionLocation, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("catalyst_region.test", "store_join_token", "true"),
+						resource.TestMatchResourceAttr("catalyst_region.test", "join_token", regexp.MustCompile(`^jointoken`)),
+					),
+				},
+			},
+		})
+}
+
+func TestMockRegionResourceIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resource.UnitTest(t,
+		resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				provider.ProviderName: providerserver.NewProtocol6WithError(
+					provider.New("test").WithClientFactory(mockResourceClientFactory(t, ctrl, map[string]bool{})),
+				),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: testAccRegionResourceConfig(regionName, regionIngress, regionHost, regionLocation),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("catalyst_region.test", map[string]knownvalue.Check{
+							"organization_id": knownvalue.StringExact(orgID),
+							"name":            knownvalue.StringExact(regionName),
+						}),
+					},
+				},
+				// import by composite ID
+				{
+					ResourceName:                         "catalyst_region.test",
+					ImportState:                          true,
+					ImportStateVerifyIdentifierAttribute: "name",
+					ImportStateId:                        fmt.Sprintf("%s/%s", orgID, regionName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIgnore:              []string{"join_token"},
+				},
+				// import by identity
+				{
+					ResourceName:    "catalyst_region.test",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+				{
+					ResourceName:  "catalyst_region.test",
+					ImportState:   true,
+					ImportStateId: fmt.Sprintf("other-org/%s", regionName),
+					ExpectError:   regexp.MustCompile(`Organization Mismatch`),
+				},
+				{
+					ResourceName:  "catalyst_region.test",
+					ImportState:   true,
+					ImportStateId: "missing",
+					ExpectError:   regexp.MustCompile(`Cannot Import Non-Existent Object`),
+				},
+			},
+		})
+}
+
+// mockResourceClientFactory returns a factory of clients sharing a region,
+// and the projects in it, for the commands of a single test.
+func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller, projects map[string]bool) provider.ClientFactory {
+	var region *cloudruntime_client.Region
+
 	return func(endpoint, apiKey string) (catalyst.Client, error) {
 		c := catalyst.NewMockClient(ctrl)
 
+		c.EXPECT().
+			GetUserOrg(gomock.Any()).
+			Return(&conductor_client.Organization{
+				Data: conductor_client.OrganizationData{
+					Id: lo.ToPtr(orgID),
+				},
+			}, nil).
+			AnyTimes()
+
 		c.EXPECT().
 			GetRegion(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, _ string) (*cloudruntime_client.Region, error) {
-				if region == nil {
+			DoAndReturn(func(_ context.Context, name string) (*cloudruntime_client.Region, error) {
+				if region == nil || *region.Metadata.Name != name {
 					return nil, diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
 				}
 				return region, nil
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			ListRegions(gomock.Any()).
+			DoAndReturn(func(_ context.Context) ([]cloudruntime_client.Region, error) {
+				if region == nil {
+					return nil, nil
+				}
+				return []cloudruntime_client.Region{*region}, nil
+			}).
+			AnyTimes()
+
 		c.EXPECT().
 			CreateRegion(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, r *cloudruntime_client.Region) (string, error) {
@@ -187,6 +451,48 @@ func mockResourceClientFactory(t *testing.T, ctrl *gomock.Controller) provider.C
 			}).
 			AnyTimes()
 
+		c.EXPECT().
+			GetRegionJoinToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, name string) (string, error) {
+				if region == nil || *region.Metadata.Name != name {
+					return "", diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+				return regionJoinToken, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			ListProjects(gomock.Any()).
+			DoAndReturn(func(_ context.Context) ([]cloudruntime_client.Project, error) {
+				var items []cloudruntime_client.Project
+				for name := range projects {
+					items = append(items, cloudruntime_client.Project{
+						Metadata: &client.Metadata{Name: lo.ToPtr(name)},
+						Spec:     &client.ProjectSpec{Region: lo.ToPtr(regionName)},
+					})
+				}
+				return items, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			GetProject(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, name string, _ *cloudruntime_client.DescribeProjectParams) (*cloudruntime_client.Project, error) {
+				if !projects[name] {
+					return nil, diagrid_errors.NewDiagridCloudError(http.StatusNotFound)
+				}
+				return &cloudruntime_client.Project{}, nil
+			}).
+			AnyTimes()
+
+		c.EXPECT().
+			DeleteProject(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, name string) error {
+				delete(projects, name)
+				return nil
+			}).
+			AnyTimes()
+
 		return c, nil
 	}
 }
@@ -201,3 +507,39 @@ resource "catalyst_region" "test" {
 }
 `, name, ingress, host, location)
 }
+
+func testAccRegionResourceConfigWithDeletionProtection(name, ingress, host, location string, protect bool) string {
+	return fmt.Sprintf(`
+resource "catalyst_region" "test" {
+  name = %q
+  ingress = %q
+  host = %q
+  location = %q
+  deletion_protection = %t
+}
+`, name, ingress, host, location, protect)
+}
+
+func testAccRegionResourceConfigWithStoreJoinToken(name, ingress, host, location string, store bool) string {
+	return fmt.Sprintf(`
+resource "catalyst_region" "test" {
+  name = %q
+  ingress = %q
+  host = %q
+  location = %q
+  store_join_token = %t
+}
+`, name, ingress, host, location, store)
+}
+
+func testAccRegionResourceConfigWithForceDestroy(name, ingress, host, location string, force bool) string {
+	return fmt.Sprintf(`
+resource "catalyst_region" "test" {
+  name = %q
+  ingress = %q
+  host = %q
+  location = %q
+  force_destroy = %t
+}
+`, name, ingress, host, location, force)
+}
@@ -0,0 +1,88 @@
+package region
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/customtypes"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+// schemaVersion is the version of the region resource schema. Bump it and
+// add an upgrader whenever existing state can no longer be read as is.
+const schemaVersion = 1
+
+// modelV0 describes regions stored before the schema was versioned.
+type modelV0 struct {
+	Name               types.String `tfsdk:"name"`
+	Host               types.String `tfsdk:"host"`
+	Ingress            types.String `tfsdk:"ingress"`
+	Location           types.String `tfsdk:"location"`
+	JoinToken          types.String `tfsdk:"join_token"`
+	Type               types.String `tfsdk:"type"`
+	Connected          types.Bool   `tfsdk:"connected"`
+	Clusters           types.List   `tfsdk:"clusters"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+}
+
+// schemaV0 is the schema of regions stored before the schema was
+// versioned. Attributes added without a version bump are optional, so state
+// written by any of those releases can be read.
+func schemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":       schema.StringAttribute{Required: true},
+			"host":       schema.StringAttribute{Optional: true},
+			"ingress":    schema.StringAttribute{Required: true},
+			"location":   schema.StringAttribute{Optional: true},
+			"join_token": schema.StringAttribute{Computed: true, Sensitive: true},
+			"type":       schema.StringAttribute{Computed: true},
+			"connected":  schema.BoolAttribute{Computed: true},
+			"clusters": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: clusterAttrTypes},
+				Computed:    true,
+			},
+			"adopt_existing":      schema.BoolAttribute{Optional: true, Computed: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"force_destroy":       schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// upgradeStateV0 fills in the defaults of attributes missing from
+// unversioned state, so upgrading the provider doesn't plan changes to them.
+// Tokens stored before store_join_token existed are kept.
+func upgradeStateV0(ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior modelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := NewResourceModel()
+	model.Name = prior.Name
+	model.Host = customtypes.Host{StringValue: prior.Host}
+	model.Ingress = customtypes.URL{StringValue: prior.Ingress}
+	model.Location = prior.Location
+	model.JoinToken = prior.JoinToken
+	model.Type = prior.Type
+	model.Connected = prior.Connected
+	model.Clusters = prior.Clusters
+	if model.Clusters.IsNull() {
+		model.SetClusters(nil)
+	}
+	model.AdoptExisting = helpers.BoolWithDefault(prior.AdoptExisting, false)
+	model.DeletionProtection = helpers.BoolWithDefault(prior.DeletionProtection, false)
+	model.ForceDestroy = helpers.BoolWithDefault(prior.ForceDestroy, false)
+	model.SetStoreJoinToken(true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,14 @@
+package region_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	catalyst_region "github.com/diagridio/terraform-provider-catalyst/internal/provider/region"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/stateupgrade"
+)
+
+func TestRegionResourceUpgradeState(t *testing.T) {
+	stateupgrade.Replay(t, catalyst_region.NewResource().(resource.ResourceWithUpgradeState), "testdata/state")
+}
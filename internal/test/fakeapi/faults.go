@@ -0,0 +1,226 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault makes the server misbehave on the requests it matches.
+type Fault struct {
+	// Method matches the request method, any method when empty.
+	Method string
+	// Path matches requests whose path contains it, such as "/projects" or
+	// "/regions/region1", any path when empty.
+	Path string
+
+	// Status fails matching requests with the HTTP status, such as 500 or
+	// 429, instead of serving them.
+	Status int
+	// Rate is the fraction of matching requests failing with Status, every
+	// request when zero.
+	Rate float64
+	// Times limits how many requests fail with Status, no limit when zero.
+	Times int
+	// Code, Message and Fields are the error code, message and invalid
+	// fields of the error response of failing requests, if any.
+	Code    string
+	Message string
+	Fields  map[string]string
+	// RetryAfter is sent in the Retry-After header of failing requests, in
+	// seconds, when set.
+	RetryAfter time.Duration
+
+	// Latency delays the response to matching requests.
+	Latency time.Duration
+
+	// Drop serves matching requests but forgets the object they create, as
+	// if it was lost by the API right after being accepted.
+	Drop bool
+}
+
+// fault is an injected fault along with how often it fired.
+type fault struct {
+	Fault
+
+	fired int
+}
+
+// Inject adds a fault, applied to requests until the faults are cleared.
+// Every matching fault adds its latency, while the first failing one
+// decides the response.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{Fault: f})
+}
+
+// ClearFaults removes every injected fault and stuck status.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+	s.stuck = make(map[string]string)
+}
+
+// StickProject keeps the status of a project, whether it exists yet or not,
+// until the faults are cleared.
+func (s *Server) StickProject(name, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stuck["projects/"+name] = status
+}
+
+// StickRegion keeps the status of a region, whether it exists yet or not,
+// until the faults are cleared.
+func (s *Server) StickRegion(name, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stuck["regions/"+name] = status
+}
+
+// DropProject deletes a project behind the back of its clients.
+func (s *Server) DropProject(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.projects, name)
+	delete(s.appIDs, name)
+}
+
+// DropRegion deletes a region behind the back of its clients.
+func (s *Server) DropRegion(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.regions, name)
+	delete(s.joinTokens, name)
+}
+
+// Requests returns how many requests were received with the method and a
+// path containing path, any of them when empty.
+func (s *Server) Requests(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, request := range s.requests {
+		m, p, _ := strings.Cut(request, " ")
+		if (method == "" || m == method) && strings.Contains(p, path) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// injectFaults applies the faults matching the request. It returns the
+// combined fault and whether the request should still be served.
+func (s *Server) injectFaults(w http.ResponseWriter, r *http.Request) (Fault, bool) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", len(s.requests)))
+
+	var applied Fault
+	for _, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+
+		applied.Latency += f.Latency
+		applied.Drop = applied.Drop || f.Drop
+
+		if applied.Status != 0 || f.Status == 0 ||
+			(f.Times > 0 && f.fired >= f.Times) ||
+			(f.Rate > 0 && s.random.Float64() >= f.Rate) {
+			continue
+		}
+		f.fired++
+		applied.Status = f.Status
+		applied.Code = f.Code
+		applied.Message = f.Message
+		applied.Fields = f.Fields
+		applied.RetryAfter = f.RetryAfter
+	}
+	s.mu.Unlock()
+
+	if applied.Latency > 0 {
+		select {
+		case <-time.After(applied.Latency):
+		case <-r.Context().Done():
+			return applied, false
+		}
+	}
+
+	if applied.Status != 0 {
+		writeFault(w, applied)
+		return applied, false
+	}
+
+	return applied, true
+}
+
+// writeFault writes the error response of a fault.
+func writeFault(w http.ResponseWriter, f Fault) {
+	body := map[string]any{
+		"code":    f.Status,
+		"message": http.StatusText(f.Status),
+	}
+	if f.Code != "" {
+		body["code"] = f.Code
+	}
+	if f.Message != "" {
+		body["message"] = f.Message
+	}
+	if len(f.Fields) > 0 {
+		body["fields"] = f.Fields
+	}
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Seconds())))
+	}
+	writeJSON(w, f.Status, body)
+}
+
+func (f *fault) matches(r *http.Request) bool {
+	return (f.Method == "" || strings.EqualFold(f.Method, r.Method)) &&
+		strings.Contains(r.URL.Path, f.Path)
+}
+
+// peekName returns the name of the object in the request body, leaving the
+// body intact.
+func peekName(r *http.Request) string {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return ""
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var object struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	_ = json.Unmarshal(body, &object)
+
+	return object.Metadata.Name
+}
+
+// forget removes the object created by a dropped request.
+func (s *Server) forget(path, name string) {
+	switch {
+	case strings.Contains(path, "/projects"):
+		delete(s.projects, name)
+	case strings.Contains(path, "/regions"):
+		delete(s.regions, name)
+		delete(s.joinTokens, name)
+	}
+}
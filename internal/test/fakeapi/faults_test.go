@@ -0,0 +1,79 @@
+package fakeapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+)
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+	s, c := newClient(t, fakeapi.WithReadyAfter(0))
+	s.AddRegion(*newRegion("region1"))
+
+	s.Inject(fakeapi.Fault{Method: http.MethodGet, Path: "/regions/region1", Status: http.StatusInternalServerError, Times: 2})
+	for range 2 {
+		if _, err := c.GetRegion(ctx, "region1"); err == nil {
+			t.Errorf("expected the fault to fail the request")
+		}
+	}
+	if _, err := c.GetRegion(ctx, "region1"); err != nil {
+		t.Errorf("expected the fault to be exhausted, got %s", err)
+	}
+	if n := s.Requests(http.MethodGet, "/regions/region1"); n != 3 {
+		t.Errorf("expected 3 requests, got %d", n)
+	}
+
+	s.Inject(fakeapi.Fault{Method: http.MethodGet, Path: "/projects", Latency: 50 * time.Millisecond})
+	start := time.Now()
+	if _, err := c.ListProjects(ctx); err != nil {
+		t.Errorf("listing projects: %s", err)
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Errorf("expected the response to be delayed")
+	}
+
+	s.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "/projects", Drop: true})
+	if err := c.CreateProject(ctx, newProject("dropped", "region1")); err != nil {
+		t.Fatalf("creating project: %s", err)
+	}
+	_, err := c.GetProject(ctx, "dropped", &cloudruntime_client.DescribeProjectParams{})
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected the dropped project to be not found, got %v", err)
+	}
+
+	s.StickRegion("region1", "processing")
+	expectRegionStatus(t, c, "region1", "processing")
+
+	s.ClearFaults()
+	expectRegionStatus(t, c, "region1", "ready")
+
+	s.DropRegion("region1")
+	_, err = c.GetRegion(ctx, "region1")
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected the dropped region to be not found, got %v", err)
+	}
+}
+
+func TestFaultRate(t *testing.T) {
+	ctx := context.Background()
+	s, c := newClient(t)
+
+	s.Inject(fakeapi.Fault{Path: "/users", Status: http.StatusInternalServerError, Rate: 0.5})
+
+	failed := 0
+	for range 100 {
+		if _, err := c.GetUserOrg(ctx); err != nil {
+			failed++
+		}
+	}
+	if failed < 25 || failed > 75 {
+		t.Errorf("expected about half of the requests to fail, got %d", failed)
+	}
+}
@@ -0,0 +1,431 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+)
+
+// AddRegion stores a ready region, such as a public region the provider
+// can't create.
+func (s *Server) AddRegion(region cloudruntime_client.Region) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &object[cloudruntime_client.Region]{value: region, status: statusReady}
+	s.regions[lo.FromPtr(lo.FromPtr(region.Metadata).Name)] = o
+}
+
+// Region returns a region as served by the API.
+func (s *Server) Region(name string) (cloudruntime_client.Region, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.regions[name]
+	if !ok {
+		return cloudruntime_client.Region{}, false
+	}
+
+	return regionView(o), true
+}
+
+// AddProject stores a ready project, such as one created outside of
+// Terraform.
+func (s *Server) AddProject(project cloudruntime_client.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &object[cloudruntime_client.Project]{value: project, status: statusReady}
+	s.projects[lo.FromPtr(lo.FromPtr(project.Metadata).Name)] = o
+}
+
+// Project returns a project as served by the API.
+func (s *Server) Project(name string) (cloudruntime_client.Project, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.projects[name]
+	if !ok {
+		return cloudruntime_client.Project{}, false
+	}
+
+	return projectView(o), true
+}
+
+// AddAppID stores an App ID in a project.
+func (s *Server) AddAppID(project, appID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.appIDs[project] == nil {
+		s.appIDs[project] = make(map[string]bool)
+	}
+	s.appIDs[project][appID] = true
+}
+
+// APIKey returns an API key as served by the API.
+func (s *Server) APIKey(id string) (conductor_client.APIKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[id]
+	if !ok {
+		return conductor_client.APIKey{}, false
+	}
+
+	return *key, true
+}
+
+func (s *Server) serveRegions(w http.ResponseWriter, r *http.Request, tail []string) {
+	switch {
+	case len(tail) == 0 && r.Method == http.MethodGet:
+		names := lo.Keys(s.regions)
+		sort.Strings(names)
+
+		// listing reads every object, advancing their lifecycle
+		items := make([]cloudruntime_client.Region, 0, len(names))
+		for _, name := range names {
+			if o, ok := s.readRegion(name); ok {
+				items = append(items, regionView(o))
+			}
+		}
+		writeJSON(w, http.StatusOK, cloudruntime_client.RegionList{Items: &items})
+
+	case len(tail) == 0 && r.Method == http.MethodPost:
+		var region cloudruntime_client.Region
+		if !decode(w, r, &region) {
+			return
+		}
+		name := lo.FromPtr(lo.FromPtr(region.Metadata).Name)
+		if name == "" {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.regions[name]; ok {
+			writeError(w, http.StatusConflict)
+			return
+		}
+
+		region.Kind = lo.ToPtr(catalyst.KindRegion)
+		region.Metadata.Uid = lo.ToPtr(s.newID("region"))
+		if region.Spec == nil {
+			region.Spec = &cloudruntime_client.RegionSpec{}
+		}
+		region.Spec.Type = lo.ToPtr(catalyst.RegionTypePrivate)
+		s.regions[name] = lifecycle(s, region)
+		s.joinTokens[name] = s.newID("jointoken")
+
+		writeJSON(w, http.StatusCreated, cloudruntime_client.CreatePrivateRegionResponse{
+			JoinToken: lo.ToPtr(s.joinTokens[name]),
+		})
+
+	case len(tail) == 1 && r.Method == http.MethodGet:
+		o, ok := s.readRegion(tail[0])
+		if !ok {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, regionView(o))
+
+	case len(tail) == 1 && (r.Method == http.MethodPut || r.Method == http.MethodPatch):
+		o, ok := s.regions[tail[0]]
+		if !ok || o.deleting {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		var region cloudruntime_client.Region
+		if !decode(w, r, &region) {
+			return
+		}
+		if region.Spec != nil {
+			spec := lo.FromPtr(o.value.Spec)
+			spec.Host = lo.CoalesceOrEmpty(region.Spec.Host, spec.Host)
+			spec.Ingress = lo.CoalesceOrEmpty(region.Spec.Ingress, spec.Ingress)
+			spec.Location = lo.CoalesceOrEmpty(region.Spec.Location, spec.Location)
+			o.value.Spec = &spec
+		}
+		restart(s, o)
+		w.WriteHeader(http.StatusNoContent)
+
+	case len(tail) == 1 && r.Method == http.MethodDelete:
+		o, ok := s.regions[tail[0]]
+		if !ok || o.deleting {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		if lo.ContainsBy(lo.Values(s.projects), func(p *object[cloudruntime_client.Project]) bool {
+			return !p.deleting && lo.FromPtr(lo.FromPtr(p.value.Spec).Region) == tail[0]
+		}) {
+			writeError(w, http.StatusConflict)
+			return
+		}
+		if remove(s, o, "regions/"+tail[0]) {
+			delete(s.regions, tail[0])
+			delete(s.joinTokens, tail[0])
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	case len(tail) == 2 && r.Method == http.MethodGet && strings.Contains(strings.ToLower(tail[1]), "token"):
+		token, ok := s.joinTokens[tail[0]]
+		if o, exists := s.regions[tail[0]]; !ok || !exists || o.deleting {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, cloudruntime_client.PrivateRegionJoinToken{JoinToken: lo.ToPtr(token)})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+// readRegion returns a region, advancing its lifecycle.
+func (s *Server) readRegion(name string) (*object[cloudruntime_client.Region], bool) {
+	o, ok := s.regions[name]
+	if !ok {
+		return nil, false
+	}
+	if status, ok := s.stuck["regions/"+name]; ok {
+		o.status = status
+		return o, true
+	}
+	if read(s, o) {
+		delete(s.regions, name)
+		delete(s.joinTokens, name)
+		return nil, false
+	}
+
+	return o, true
+}
+
+func regionView(o *object[cloudruntime_client.Region]) cloudruntime_client.Region {
+	region := o.value
+	status := lo.FromPtr(region.Status)
+	status.Status = lo.ToPtr(o.status)
+	status.Connected = lo.ToPtr(lo.FromPtr(status.Connected))
+	region.Status = &status
+
+	return region
+}
+
+func (s *Server) serveProjects(w http.ResponseWriter, r *http.Request, tail []string) {
+	switch {
+	case len(tail) == 0 && r.Method == http.MethodGet:
+		names := lo.Keys(s.projects)
+		sort.Strings(names)
+
+		// listing reads every object, advancing their lifecycle
+		items := make([]cloudruntime_client.Project, 0, len(names))
+		for _, name := range names {
+			if o, ok := s.readProject(name); ok {
+				items = append(items, projectView(o))
+			}
+		}
+		writeJSON(w, http.StatusOK, cloudruntime_client.ProjectList{Items: &items})
+
+	case len(tail) == 0 && r.Method == http.MethodPost:
+		var project cloudruntime_client.Project
+		if !decode(w, r, &project) {
+			return
+		}
+		name := lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+		if name == "" {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.projects[name]; ok {
+			writeError(w, http.StatusConflict)
+			return
+		}
+		region, ok := s.regions[lo.FromPtr(lo.FromPtr(project.Spec).Region)]
+		if !ok || region.deleting {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+
+		project.Kind = lo.ToPtr(catalyst.KindProject)
+		project.Metadata.Uid = lo.ToPtr(s.newID("project"))
+		s.projects[name] = lifecycle(s, project)
+		w.WriteHeader(http.StatusCreated)
+
+	case len(tail) == 1 && r.Method == http.MethodGet:
+		o, ok := s.readProject(tail[0])
+		if !ok {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, projectView(o))
+
+	// patches may address the project by path or by the name in the body
+	case len(tail) <= 1 && (r.Method == http.MethodPatch || r.Method == http.MethodPut):
+		var project cloudruntime_client.Project
+		if !decode(w, r, &project) {
+			return
+		}
+		name := lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+		if len(tail) == 1 {
+			name = tail[0]
+		}
+		o, ok := s.projects[name]
+		if !ok || o.deleting {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		if project.Spec != nil {
+			spec := lo.FromPtr(o.value.Spec)
+			spec.DisplayName = lo.CoalesceOrEmpty(project.Spec.DisplayName, spec.DisplayName)
+			o.value.Spec = &spec
+		}
+		restart(s, o)
+		w.WriteHeader(http.StatusNoContent)
+
+	case len(tail) == 1 && r.Method == http.MethodDelete:
+		o, ok := s.projects[tail[0]]
+		if !ok || o.deleting {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		if remove(s, o, "projects/"+tail[0]) {
+			delete(s.projects, tail[0])
+			delete(s.appIDs, tail[0])
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+// readProject returns a project, advancing its lifecycle.
+func (s *Server) readProject(name string) (*object[cloudruntime_client.Project], bool) {
+	o, ok := s.projects[name]
+	if !ok {
+		return nil, false
+	}
+	if status, ok := s.stuck["projects/"+name]; ok {
+		o.status = status
+		return o, true
+	}
+	if read(s, o) {
+		delete(s.projects, name)
+		delete(s.appIDs, name)
+		return nil, false
+	}
+
+	return o, true
+}
+
+// projectView returns the project with its status, endpoints only being
+// assigned once it is ready.
+func projectView(o *object[cloudruntime_client.Project]) cloudruntime_client.Project {
+	project := o.value
+	status := lo.FromPtr(project.Status)
+	status.Status = lo.ToPtr(o.status)
+	if o.status == statusReady && status.Endpoints == nil {
+		name := lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+		status.Endpoints = &cloudruntime_client.ProjectStatusEndpoint{
+			Grpc: &cloudruntime_client.ProjectStatusEndpointDetails{
+				Url: lo.ToPtr("grpc-" + name + ".example.com:443"),
+			},
+			Http: &cloudruntime_client.ProjectStatusEndpointDetails{
+				Url: lo.ToPtr("https://http-" + name + ".example.com"),
+			},
+		}
+	}
+	project.Status = &status
+
+	return project
+}
+
+func (s *Server) serveAppIDs(w http.ResponseWriter, r *http.Request, project string, tail []string) {
+	if r.Method != http.MethodGet || len(tail) != 2 || !strings.Contains(strings.ToLower(tail[1]), "token") {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+	if o, ok := s.projects[project]; !ok || o.deleting || !s.appIDs[project][tail[0]] {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cloudruntime_client.AppIDAPIToken{
+		Token: lo.ToPtr(s.newID("apitoken")),
+	})
+}
+
+func (s *Server) serveAPIKeys(w http.ResponseWriter, r *http.Request, tail []string) {
+	switch {
+	case len(tail) == 0 && r.Method == http.MethodPost:
+		var req conductor_client.APIKeyRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if lo.FromPtr(req.Name) == "" {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+
+		createdAt := time.Now().UTC().Truncate(time.Second)
+		attributes := conductor_client.APIKeyAttributes{
+			Name:      req.Name,
+			Role:      req.Role,
+			Scopes:    req.Scopes,
+			CreatedBy: lo.ToPtr(s.user),
+			CreatedAt: lo.ToPtr(createdAt),
+		}
+		if req.Duration != nil {
+			attributes.ExpiresAt = lo.ToPtr(createdAt.Add(time.Duration(*req.Duration) * time.Second))
+		}
+
+		id := s.newID("apikey")
+		s.apiKeys[id] = &conductor_client.APIKey{
+			Data: conductor_client.APIKeyData{
+				Id:         lo.ToPtr(id),
+				Attributes: lo.ToPtr(attributes),
+			},
+		}
+
+		// the token is only part of the creation response
+		attributes.Token = lo.ToPtr(s.newID("diagrid"))
+		writeJSON(w, http.StatusCreated, conductor_client.APIKey{
+			Data: conductor_client.APIKeyData{
+				Id:         lo.ToPtr(id),
+				Attributes: &attributes,
+			},
+		})
+
+	case len(tail) == 0 && r.Method == http.MethodGet:
+		ids := lo.Keys(s.apiKeys)
+		sort.Strings(ids)
+		list := conductor_client.APIKeyList{Data: []conductor_client.APIKeyData{}}
+		for _, id := range ids {
+			list.Data = append(list.Data, s.apiKeys[id].Data)
+		}
+		writeJSON(w, http.StatusOK, list)
+
+	case len(tail) == 1 && r.Method == http.MethodGet:
+		key, ok := s.apiKeys[tail[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, key)
+
+	case len(tail) == 1 && r.Method == http.MethodDelete:
+		if _, ok := s.apiKeys[tail[0]]; !ok {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		delete(s.apiKeys, tail[0])
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
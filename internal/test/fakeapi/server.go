@@ -0,0 +1,329 @@
+// Package fakeapi is an in-process fake of the Catalyst API. It serves the
+// cloudruntime and management endpoints used by the catalyst client from an
+// in-memory store, so provider tests can run the real client end to end
+// without network access or credentials.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+)
+
+const (
+	// DefaultOrganizationID is the organization of the current user unless
+	// WithOrganization is given.
+	DefaultOrganizationID = "00000000-0000-0000-0000-000000000000"
+
+	// APIKey is the placeholder API key used when none is configured.
+	APIKey = "fake-api-key"
+
+	statusProcessing = "processing"
+	statusReady      = "ready"
+	statusDeleting   = "deleting"
+)
+
+// Server is a fake Catalyst API backed by an in-memory store.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	orgID   string
+	orgName string
+	user    string
+
+	// readyAfter is the number of reads an object stays in processing, or
+	// deleting, before it becomes ready, or gone.
+	readyAfter int
+
+	regions  map[string]*object[cloudruntime_client.Region]
+	projects map[string]*object[cloudruntime_client.Project]
+	appIDs   map[string]map[string]bool
+	apiKeys  map[string]*conductor_client.APIKey
+
+	// joinTokens holds the join token of each private region.
+	joinTokens map[string]string
+
+	faults   []*fault
+	stuck    map[string]string
+	requests []string
+	random   *rand.Rand
+
+	nextID int
+}
+
+// object is a stored API object along with its lifecycle.
+type object[T any] struct {
+	value T
+
+	status   string
+	reads    int
+	deleting bool
+}
+
+// Option configures the server.
+type Option func(*Server)
+
+// WithOrganization sets the organization of the current user.
+func WithOrganization(id, name string) Option {
+	return func(s *Server) {
+		s.orgID = id
+		s.orgName = name
+	}
+}
+
+// WithReadyAfter sets how many reads created objects stay in processing,
+// and deleted objects stay in deleting, before they change state. Zero
+// makes every change immediate.
+func WithReadyAfter(reads int) Option {
+	return func(s *Server) {
+		s.readyAfter = reads
+	}
+}
+
+// New starts a server, closed when the test completes.
+func New(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	s := &Server{
+		orgID:      DefaultOrganizationID,
+		orgName:    "fake",
+		user:       "user@diagrid.io",
+		readyAfter: 1,
+		regions:    make(map[string]*object[cloudruntime_client.Region]),
+		projects:   make(map[string]*object[cloudruntime_client.Project]),
+		appIDs:     make(map[string]map[string]bool),
+		apiKeys:    make(map[string]*conductor_client.APIKey),
+		joinTokens: make(map[string]string),
+		stuck:      make(map[string]string),
+		random:     rand.New(rand.NewSource(1)),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// OrganizationID returns the organization of the current user.
+func (s *Server) OrganizationID() string {
+	return s.orgID
+}
+
+// ClientFactory returns a client factory running catalyst.NewClient against
+// the server, whatever endpoint the provider is configured with.
+func (s *Server) ClientFactory() provider.ClientFactory {
+	return func(_, apiKey string) (catalyst.Client, error) {
+		if apiKey == "" {
+			apiKey = APIKey
+		}
+
+		return catalyst.NewClient(s.URL, apiKey)
+	}
+}
+
+// ProviderFactories returns provider factories for resource.TestCase using
+// the server.
+func (s *Server) ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		provider.ProviderName: providerserver.NewProtocol6WithError(
+			provider.New("test").WithClientFactory(s.ClientFactory()),
+		),
+	}
+}
+
+// ServeHTTP injects the faults matching the request before serving it.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fault, ok := s.injectFaults(w, r)
+	if !ok {
+		return
+	}
+
+	var name string
+	if fault.Drop {
+		name = peekName(r)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.route(w, r)
+	if fault.Drop {
+		s.forget(r.URL.Path, name)
+	}
+}
+
+// route serves requests on their trailing path segments, so the server is
+// agnostic of the prefixes and versions the API is mounted under.
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	segments := strings.FieldsFunc(r.URL.Path, func(c rune) bool { return c == '/' })
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		tail := segments[i+1:]
+
+		switch strings.ToLower(segments[i]) {
+		case "apikeys", "api-keys", "api_keys":
+			if i < 1 || segments[i-1] != s.orgID {
+				writeError(w, http.StatusNotFound)
+				return
+			}
+			s.serveAPIKeys(w, r, tail)
+			return
+		case "appids", "app-ids", "app_ids":
+			if i < 2 || !strings.EqualFold(segments[i-2], "projects") {
+				continue
+			}
+			s.serveAppIDs(w, r, segments[i-1], tail)
+			return
+		case "projects":
+			s.serveProjects(w, r, tail)
+			return
+		case "regions":
+			s.serveRegions(w, r, tail)
+			return
+		case "organizations", "orgs":
+			s.serveOrganizations(w, r, tail)
+			return
+		case "users", "user":
+			s.serveUsers(w, r, tail)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound)
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, tail []string) {
+	if r.Method != http.MethodGet || len(tail) > 1 {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, conductor_client.User{
+		Data: conductor_client.UserData{
+			Attributes: conductor_client.UserAttributes{
+				Organization: conductor_client.UserOrganization{
+					Id: lo.ToPtr(s.orgID),
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) serveOrganizations(w http.ResponseWriter, r *http.Request, tail []string) {
+	if r.Method != http.MethodGet || len(tail) != 1 {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+	if tail[0] != s.orgID {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, conductor_client.Organization{
+		Data: conductor_client.OrganizationData{
+			Id: lo.ToPtr(s.orgID),
+			Attributes: &conductor_client.OrganizationAttributes{
+				Name: lo.ToPtr(s.orgName),
+			},
+		},
+	})
+}
+
+// newID returns a unique identifier for a created object.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%06d", prefix, s.nextID)
+}
+
+// read advances the lifecycle of an object on every read. It reports
+// whether the object is gone, after which it must be removed.
+func read[T any](s *Server, o *object[T]) (gone bool) {
+	o.reads++
+	if o.reads <= s.readyAfter {
+		return false
+	}
+
+	if o.deleting {
+		return true
+	}
+	o.status = statusReady
+
+	return false
+}
+
+// lifecycle starts the lifecycle of a created object.
+func lifecycle[T any](s *Server, value T) *object[T] {
+	o := &object[T]{value: value, status: statusProcessing}
+	if s.readyAfter == 0 {
+		o.status = statusReady
+	}
+
+	return o
+}
+
+// restart puts an updated object back in processing.
+func restart[T any](s *Server, o *object[T]) {
+	if s.readyAfter == 0 {
+		return
+	}
+
+	o.status = statusProcessing
+	o.reads = 0
+}
+
+// remove starts the deletion of an object, reporting whether it is gone
+// right away. Objects with a stuck status are never gone until the faults
+// are cleared.
+func remove[T any](s *Server, o *object[T], key string) (gone bool) {
+	if _, stuck := s.stuck[key]; s.readyAfter == 0 && !stuck {
+		return true
+	}
+
+	o.deleting = true
+	o.status = statusDeleting
+	o.reads = 0
+
+	return false
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int) {
+	writeJSON(w, code, map[string]any{
+		"code":    code,
+		"message": http.StatusText(code),
+	})
+}
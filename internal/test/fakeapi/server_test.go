@@ -0,0 +1,262 @@
+package fakeapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+)
+
+func newClient(t *testing.T, opts ...fakeapi.Option) (*fakeapi.Server, catalyst.Client) {
+	t.Helper()
+
+	s := fakeapi.New(t, opts...)
+	c, err := s.ClientFactory()("", "")
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return s, c
+}
+
+func TestUserOrg(t *testing.T) {
+	_, c := newClient(t, fakeapi.WithOrganization("org-1", "acme"))
+
+	org, err := c.GetUserOrg(context.Background())
+	if err != nil {
+		t.Fatalf("getting org: %s", err)
+	}
+	if lo.FromPtr(org.Data.Id) != "org-1" ||
+		lo.FromPtr(lo.FromPtr(org.Data.Attributes).Name) != "acme" {
+		t.Errorf("unexpected org %+v", org.Data)
+	}
+}
+
+func TestRegionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, c := newClient(t)
+
+	joinToken, err := c.CreateRegion(ctx, newRegion("region1"))
+	if err != nil {
+		t.Fatalf("creating region: %s", err)
+	}
+	if joinToken == "" {
+		t.Errorf("expected a join token")
+	}
+	if _, err := c.CreateRegion(ctx, newRegion("region1")); err == nil {
+		t.Errorf("expected creating a duplicate region to fail")
+	}
+
+	expectRegionStatus(t, c, "region1", "processing")
+	expectRegionStatus(t, c, "region1", "ready")
+
+	token, err := c.GetRegionJoinToken(ctx, "region1")
+	if err != nil || token != joinToken {
+		t.Errorf("expected join token %s, got %s (%v)", joinToken, token, err)
+	}
+
+	region := newRegion("region1")
+	region.Spec.Host = lo.ToPtr("host2")
+	if err := c.UpdateRegion(ctx, region); err != nil {
+		t.Fatalf("updating region: %s", err)
+	}
+	updated, err := c.GetRegion(ctx, "region1")
+	if err != nil || lo.FromPtr(updated.Spec.Host) != "host2" {
+		t.Errorf("expected updated host, got %+v (%v)", updated, err)
+	}
+
+	regions, err := c.ListRegions(ctx)
+	if err != nil || len(regions) != 1 {
+		t.Errorf("expected 1 region, got %d (%v)", len(regions), err)
+	}
+
+	if err := c.DeleteRegion(ctx, "region1"); err != nil {
+		t.Fatalf("deleting region: %s", err)
+	}
+	expectRegionStatus(t, c, "region1", "deleting")
+
+	_, err = c.GetRegion(ctx, "region1")
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected the deleted region to be not found, got %v", err)
+	}
+	if err := c.DeleteRegion(ctx, "region1"); !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected deleting a missing region to be not found, got %v", err)
+	}
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s, c := newClient(t, fakeapi.WithReadyAfter(2))
+	s.AddRegion(*newRegion("region1"))
+
+	if err := c.CreateProject(ctx, newProject("prj", "missing")); err == nil {
+		t.Errorf("expected creating a project in a missing region to fail")
+	}
+	if err := c.CreateProject(ctx, newProject("prj", "region1")); err != nil {
+		t.Fatalf("creating project: %s", err)
+	}
+
+	expectProjectStatus(t, c, "prj", "processing")
+	expectProjectStatus(t, c, "prj", "processing")
+	project := expectProjectStatus(t, c, "prj", "ready")
+	if project.Status.Endpoints == nil {
+		t.Errorf("expected endpoints once ready")
+	}
+
+	// regions can't be deleted while they hold projects
+	if err := c.DeleteRegion(ctx, "region1"); err == nil {
+		t.Errorf("expected deleting a region with projects to fail")
+	}
+
+	update := newProject("prj", "region1")
+	update.Spec.DisplayName = lo.ToPtr("Project")
+	if err := c.UpdateProject(ctx, update); err != nil {
+		t.Fatalf("updating project: %s", err)
+	}
+	// updates put the project back in processing
+	expectProjectStatus(t, c, "prj", "processing")
+	expectProjectStatus(t, c, "prj", "processing")
+	project = expectProjectStatus(t, c, "prj", "ready")
+	if lo.FromPtr(project.Spec.DisplayName) != "Project" {
+		t.Errorf("expected updated display name, got %+v", project.Spec)
+	}
+
+	projects, err := c.ListProjects(ctx)
+	if err != nil || len(projects) != 1 {
+		t.Errorf("expected 1 project, got %d (%v)", len(projects), err)
+	}
+
+	if err := c.DeleteProject(ctx, "prj"); err != nil {
+		t.Fatalf("deleting project: %s", err)
+	}
+	expectProjectStatus(t, c, "prj", "deleting")
+	expectProjectStatus(t, c, "prj", "deleting")
+
+	_, err = c.GetProject(ctx, "prj", &cloudruntime_client.DescribeProjectParams{})
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected the deleted project to be not found, got %v", err)
+	}
+}
+
+func TestAppIDAPIToken(t *testing.T) {
+	ctx := context.Background()
+	s, c := newClient(t, fakeapi.WithReadyAfter(0))
+	s.AddRegion(*newRegion("region1"))
+	s.AddProject(*newProject("prj", "region1"))
+	s.AddAppID("prj", "app1")
+
+	token, err := c.GetAppIDAPIToken(ctx, "prj", "app1")
+	if err != nil || token == "" {
+		t.Errorf("expected a token, got %q (%v)", token, err)
+	}
+
+	_, err = c.GetAppIDAPIToken(ctx, "prj", "app2")
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected a missing app id to be not found, got %v", err)
+	}
+}
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s, c := newClient(t)
+
+	key, err := c.CreateAPIKey(ctx, &conductor_client.APIKeyRequest{
+		Name:     lo.ToPtr("ci"),
+		Role:     lo.ToPtr("cra.diagrid:editor"),
+		Duration: lo.ToPtr(int64(3600)),
+	})
+	if err != nil {
+		t.Fatalf("creating api key: %s", err)
+	}
+
+	id := lo.FromPtr(key.Data.Id)
+	attributes := lo.FromPtr(key.Data.Attributes)
+	if lo.FromPtr(attributes.Token) == "" {
+		t.Errorf("expected a token on creation")
+	}
+	if attributes.ExpiresAt == nil || attributes.ExpiresAt.Sub(*attributes.CreatedAt).Hours() != 1 {
+		t.Errorf("expected the key to expire after an hour, got %v", attributes.ExpiresAt)
+	}
+
+	read, err := c.GetAPIKey(ctx, id)
+	if err != nil {
+		t.Fatalf("getting api key: %s", err)
+	}
+	if read.Data.Attributes.Token != nil {
+		t.Errorf("expected the token to only be returned on creation")
+	}
+	if _, ok := s.APIKey(id); !ok {
+		t.Errorf("expected the key to be stored")
+	}
+
+	if err := c.DeleteAPIKey(ctx, id); err != nil {
+		t.Fatalf("deleting api key: %s", err)
+	}
+	_, err = c.GetAPIKey(ctx, id)
+	if !diagrid_errors.IsResourceNotFoundError(err) {
+		t.Errorf("expected the deleted key to be not found, got %v", err)
+	}
+}
+
+func expectRegionStatus(t *testing.T, c catalyst.Client, name, status string) *cloudruntime_client.Region {
+	t.Helper()
+
+	region, err := c.GetRegion(context.Background(), name)
+	if err != nil {
+		t.Fatalf("getting region: %s", err)
+	}
+	if actual := lo.FromPtr(region.Status.Status); actual != status {
+		t.Errorf("expected region status %s, got %s", status, actual)
+	}
+
+	return region
+}
+
+func expectProjectStatus(t *testing.T, c catalyst.Client, name, status string) *cloudruntime_client.Project {
+	t.Helper()
+
+	project, err := c.GetProject(context.Background(), name, &cloudruntime_client.DescribeProjectParams{})
+	if err != nil {
+		t.Fatalf("getting project: %s", err)
+	}
+	if actual := lo.FromPtr(project.Status.Status); actual != status {
+		t.Errorf("expected project status %s, got %s", status, actual)
+	}
+
+	return project
+}
+
+func newRegion(name string) *cloudruntime_client.Region {
+	return &cloudruntime_client.Region{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindRegion),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.RegionSpec{
+			Host:     lo.ToPtr("host"),
+			Ingress:  lo.ToPtr("https://*.example.com:443"),
+			Location: lo.ToPtr("us-west-1"),
+		},
+	}
+}
+
+func newProject(name, region string) *cloudruntime_client.Project {
+	return &cloudruntime_client.Project{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindProject),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.ProjectSpec{
+			Region: lo.ToPtr(region),
+		},
+	}
+}
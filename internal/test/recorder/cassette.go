@@ -0,0 +1,200 @@
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces secrets in recorded cassettes.
+const Redacted = "REDACTED"
+
+// sensitiveKeys are the JSON keys, compared case-insensitively, whose values
+// are redacted from recorded bodies.
+var sensitiveKeys = []string{"token", "secret", "password", "apikey", "api_key", "email"}
+
+// Cassette is the recorded HTTP interactions of a test.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request along with the response it was served.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. Headers are never recorded, so credentials
+// don't end up in cassettes.
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// key identifies the requests an interaction can be replayed for.
+func (r Request) key() string {
+	return r.Method + " " + r.Path + "?" + r.Query
+}
+
+// loadCassette reads a cassette, filling in its placeholders.
+func loadCassette(path string, substitutions map[string]string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("error reading cassette %s: %w", path, err)
+	}
+
+	for i := range cassette.Interactions {
+		cassette.Interactions[i].apply(func(s string) string {
+			for name, value := range substitutions {
+				s = strings.ReplaceAll(s, placeholder(name), value)
+			}
+			return s
+		})
+	}
+
+	return &cassette, nil
+}
+
+// save writes the cassette with the substituted values replaced by their
+// placeholders and secrets redacted.
+func (c *Cassette) save(path string, substitutions map[string]string, secrets []string) error {
+	// replace longer values first, in case one value contains another
+	names := make([]string, 0, len(substitutions))
+	for name := range substitutions {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return len(substitutions[names[i]]) > len(substitutions[names[j]])
+	})
+
+	scrubbed := Cassette{Interactions: make([]Interaction, len(c.Interactions))}
+	for i, interaction := range c.Interactions {
+		interaction.Request.Body = redact(interaction.Request.Body)
+		interaction.Response.Body = redact(interaction.Response.Body)
+		interaction.apply(func(s string) string {
+			for _, secret := range secrets {
+				if secret != "" {
+					s = strings.ReplaceAll(s, secret, Redacted)
+				}
+			}
+			for _, name := range names {
+				if value := substitutions[name]; value != "" {
+					s = strings.ReplaceAll(s, value, placeholder(name))
+				}
+			}
+			return s
+		})
+		scrubbed.Interactions[i] = interaction
+	}
+
+	data, err := json.MarshalIndent(scrubbed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// apply rewrites the recorded strings of the interaction.
+func (i *Interaction) apply(fn func(string) string) {
+	i.Request.Path = fn(i.Request.Path)
+	i.Request.Query = fn(i.Request.Query)
+	i.Request.Body = fn(i.Request.Body)
+	i.Response.Body = fn(i.Response.Body)
+}
+
+func placeholder(name string) string {
+	return "{{" + name + "}}"
+}
+
+// redact replaces the values of sensitive keys in a JSON body, leaving
+// bodies that aren't JSON untouched.
+func redact(body string) string {
+	if body == "" {
+		return body
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+
+	data, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return body
+	}
+
+	return string(data)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) {
+				if _, ok := value.(string); ok {
+					v[key] = Redacted
+				}
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameBody reports whether two bodies are equal, comparing JSON bodies by
+// value.
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+
+	ja, errA := json.Marshal(va)
+	jb, errB := json.Marshal(vb)
+
+	return errors.Join(errA, errB) == nil && string(ja) == string(jb)
+}
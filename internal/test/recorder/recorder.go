@@ -0,0 +1,327 @@
+// Package recorder records the HTTP interactions of acceptance tests with
+// the Catalyst API into cassettes, and replays them offline. Acceptance
+// tests then run without credentials while still exercising the payloads
+// the real API serves.
+//
+// The mode is read from the CATALYST_RECORDER_MODE environment variable:
+// "record" runs the test against the live API and saves its cassette, while
+// "replay" serves the saved cassette and skips the test when there is none.
+// Without it, tests are recorded when TF_ACC is set, as acceptance tests
+// always ran against the live API, and replayed otherwise.
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+)
+
+// Mode is whether interactions are recorded or replayed.
+type Mode string
+
+const (
+	// ModeReplay serves recorded interactions without network access.
+	ModeReplay Mode = "replay"
+	// ModeRecord sends requests to the live API and records them.
+	ModeRecord Mode = "record"
+
+	// ModeEnvVar selects the mode of recorders.
+	ModeEnvVar = "CATALYST_RECORDER_MODE"
+
+	// replayAPIKey is the API key used when replaying without one.
+	replayAPIKey = "replay-api-key"
+)
+
+// Recorder is an http.RoundTripper recording or replaying interactions.
+type Recorder struct {
+	t    testing.TB
+	mode Mode
+	path string
+
+	// transport sends requests while recording.
+	transport http.RoundTripper
+
+	mu            sync.Mutex
+	substitutions map[string]string
+	cassette      *Cassette
+	// loaded is whether the cassette was loaded for replay, which happens
+	// on the first request so every substitution is registered by then.
+	loaded bool
+	// replayed is the number of interactions replayed for each request key.
+	replayed map[string]int
+}
+
+// Option configures a recorder.
+type Option func(*Recorder)
+
+// WithMode overrides the mode read from the environment.
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithCassette sets the path of the cassette, testdata/cassettes/<test>.json
+// in the package of the test by default.
+func WithCassette(path string) Option {
+	return func(r *Recorder) {
+		r.path = path
+	}
+}
+
+// WithTransport sets the transport requests are sent with while recording.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// New returns a recorder for the test, saving its cassette when the test
+// succeeds in record mode. In replay mode, a test without a cassette is
+// skipped.
+//
+// The mode defaults to record when TF_ACC is set, and replay otherwise.
+func New(t testing.TB, opts ...Option) *Recorder {
+	t.Helper()
+
+	r := &Recorder{
+		t:             t,
+		mode:          defaultMode(),
+		path:          filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json"),
+		transport:     http.DefaultTransport,
+		substitutions: make(map[string]string),
+		cassette:      &Cassette{},
+		replayed:      make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	switch r.mode {
+	case ModeRecord:
+		t.Cleanup(r.save)
+	case ModeReplay:
+		if _, err := os.Stat(r.path); os.IsNotExist(err) {
+			t.Skipf("no cassette at %s, record one with %s=%s", r.path, ModeEnvVar, ModeRecord)
+		}
+	default:
+		t.Fatalf("invalid %s %q, expected %q or %q", ModeEnvVar, r.mode, ModeRecord, ModeReplay)
+	}
+
+	return r
+}
+
+// defaultMode returns the mode of the environment: the one it names, or
+// record when acceptance tests are enabled with TF_ACC and replay otherwise.
+func defaultMode() Mode {
+	if mode := os.Getenv(ModeEnvVar); mode != "" {
+		return Mode(mode)
+	}
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		return ModeRecord
+	}
+
+	return ModeReplay
+}
+
+// Recording reports whether the recorder sends requests to the live API.
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// Test runs the test case through the recorder. Recording runs it as an
+// acceptance test, which needs TF_ACC, while replaying runs it as a unit
+// test, as it needs neither credentials nor network access.
+func (r *Recorder) Test(t *testing.T, tc resource.TestCase) {
+	t.Helper()
+
+	tc.ProtoV6ProviderFactories = r.ProviderFactories()
+	if r.Recording() {
+		resource.Test(t, tc)
+		return
+	}
+
+	resource.UnitTest(t, tc)
+}
+
+// Substitute registers a value that changes between runs, such as a random
+// resource name. It is saved as a placeholder in cassettes, and the
+// placeholder is replaced by the value of the current run on replay.
+func (r *Recorder) Substitute(name, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.substitutions[name] = value
+}
+
+// HTTPClient returns an HTTP client sending its requests through the
+// recorder.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// ClientFactory returns a client factory running catalyst.NewClient
+// through the recorder.
+func (r *Recorder) ClientFactory() provider.ClientFactory {
+	return func(endpoint, apiKey string) (catalyst.Client, error) {
+		if apiKey == "" && !r.Recording() {
+			apiKey = replayAPIKey
+		}
+
+		return catalyst.NewClientWithHTTPClient(endpoint, apiKey, r.HTTPClient())
+	}
+}
+
+// ProviderFactories returns provider factories for resource.TestCase using
+// the recorder.
+func (r *Recorder) ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		provider.ProviderName: providerserver.NewProtocol6WithError(
+			provider.New("test").WithClientFactory(r.ClientFactory()),
+		),
+	}
+}
+
+// RoundTrip records or replays a request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, request)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: request,
+		Response: Response{
+			Status:      resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(body),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay serves the next interaction recorded for the request, repeating
+// the last one once they are exhausted, as polling may take more requests
+// than it did when recording.
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.loaded {
+		cassette, err := loadCassette(r.path, r.substitutions)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.loaded = true
+	}
+
+	key := request.key()
+
+	var matches []Interaction
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Request.key() == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s in %s", request.Method, request.Path, r.path)
+	}
+
+	n := r.replayed[key]
+	r.replayed[key]++
+	interaction := matches[min(n, len(matches)-1)]
+
+	if !sameBody(redact(request.Body), interaction.Request.Body) {
+		return nil, fmt.Errorf("request body of %s %s differs from the recording in %s:\n%s\nrecorded:\n%s",
+			request.Method, request.Path, r.path, request.Body, interaction.Request.Body)
+	}
+
+	header := make(http.Header)
+	if interaction.Response.ContentType != "" {
+		header.Set("Content-Type", interaction.Response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+		StatusCode:    interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// save writes the recorded cassette, unless the test failed.
+func (r *Recorder) save() {
+	if r.t.Failed() {
+		r.t.Logf("not saving cassette %s of a failed test", r.path)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	secrets := []string{os.Getenv("CATALYST_API_KEY")}
+	if err := r.cassette.save(r.path, r.substitutions, secrets); err != nil {
+		r.t.Errorf("saving cassette: %s", err)
+	}
+}
+
+func newRequest(req *http.Request) (Request, error) {
+	request := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+	}
+
+	if req.Body == nil {
+		return request, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return request, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	request.Body = string(body)
+
+	return request, nil
+}
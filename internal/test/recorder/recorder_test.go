@@ -0,0 +1,160 @@
+package recorder_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/recorder"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	var joinToken string
+	t.Run("record", func(t *testing.T) {
+		api := fakeapi.New(t)
+		rec := recorder.New(t, recorder.WithMode(recorder.ModeRecord), recorder.WithCassette(cassette))
+		rec.Substitute("region_name", "region-recorded")
+
+		c, err := rec.ClientFactory()(api.URL, fakeapi.APIKey)
+		if err != nil {
+			t.Fatalf("creating client: %s", err)
+		}
+
+		joinToken, err = c.CreateRegion(ctx, newRegion("region-recorded", "host"))
+		if err != nil {
+			t.Fatalf("creating region: %s", err)
+		}
+		expectRegion(t, c, "region-recorded", "processing")
+		expectRegion(t, c, "region-recorded", "ready")
+	})
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+	for _, secret := range []string{"region-recorded", joinToken, fakeapi.APIKey} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("expected %q to be scrubbed from the cassette:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "{{region_name}}") {
+		t.Errorf("expected the region name placeholder in the cassette:\n%s", data)
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		rec := recorder.New(t, recorder.WithMode(recorder.ModeReplay), recorder.WithCassette(cassette))
+		rec.Substitute("region_name", "region-replayed")
+
+		// nothing listens on the endpoint, every response is replayed
+		c, err := rec.ClientFactory()("http://127.0.0.1:1", "")
+		if err != nil {
+			t.Fatalf("creating client: %s", err)
+		}
+
+		token, err := c.CreateRegion(ctx, newRegion("region-replayed", "host"))
+		if err != nil {
+			t.Fatalf("creating region: %s", err)
+		}
+		if token != recorder.Redacted {
+			t.Errorf("expected a redacted join token, got %q", token)
+		}
+		expectRegion(t, c, "region-replayed", "processing")
+		expectRegion(t, c, "region-replayed", "ready")
+		// polling past the recording repeats the last response
+		expectRegion(t, c, "region-replayed", "ready")
+
+		if _, err := c.CreateRegion(ctx, newRegion("region-replayed", "other-host")); err == nil ||
+			!strings.Contains(err.Error(), "differs from the recording") {
+			t.Errorf("expected a request body mismatch, got %v", err)
+		}
+		if _, err := c.ListProjects(ctx); err == nil ||
+			!strings.Contains(err.Error(), "no recorded interaction") {
+			t.Errorf("expected a missing interaction, got %v", err)
+		}
+	})
+}
+
+func TestReplayWithoutCassette(t *testing.T) {
+	var replay *testing.T
+	t.Run("replay", func(t *testing.T) {
+		replay = t
+		recorder.New(t,
+			recorder.WithMode(recorder.ModeReplay),
+			recorder.WithCassette(filepath.Join(t.TempDir(), "missing.json")))
+		t.Errorf("expected the test to be skipped")
+	})
+
+	if !replay.Skipped() {
+		t.Errorf("expected a test without cassette to be skipped")
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		mode      string
+		acc       string
+		recording bool
+	}{
+		{name: "default"},
+		{name: "acceptance", acc: "1", recording: true},
+		{name: "replayed acceptance", mode: string(recorder.ModeReplay), acc: "1"},
+		{name: "record", mode: string(recorder.ModeRecord), recording: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(recorder.ModeEnvVar, tc.mode)
+			t.Setenv("TF_ACC", tc.acc)
+
+			// the cassette exists for replays not to be skipped
+			cassette := filepath.Join(t.TempDir(), "cassette.json")
+			if err := os.WriteFile(cassette, []byte(`{}`), 0o600); err != nil {
+				t.Fatalf("writing cassette: %s", err)
+			}
+
+			if rec := recorder.New(t, recorder.WithCassette(cassette)); rec.Recording() != tc.recording {
+				t.Errorf("expected recording to be %t", tc.recording)
+			}
+		})
+	}
+}
+
+func expectRegion(t *testing.T, c catalyst.Client, name, status string) {
+	t.Helper()
+
+	region, err := c.GetRegion(context.Background(), name)
+	if err != nil {
+		t.Fatalf("getting region: %s", err)
+	}
+	if actual := lo.FromPtr(region.Metadata.Name); actual != name {
+		t.Errorf("expected region %s, got %s", name, actual)
+	}
+	if actual := lo.FromPtr(region.Status.Status); actual != status {
+		t.Errorf("expected region status %s, got %s", status, actual)
+	}
+}
+
+func newRegion(name, host string) *cloudruntime_client.Region {
+	return &cloudruntime_client.Region{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindRegion),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.RegionSpec{
+			Host:     lo.ToPtr(host),
+			Ingress:  lo.ToPtr("https://*.example.com:443"),
+			Location: lo.ToPtr("us-west-1"),
+		},
+	}
+}
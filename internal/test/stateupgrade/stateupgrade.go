@@ -0,0 +1,135 @@
+// Package stateupgrade replays state written by older releases of the
+// provider through the state upgraders of a resource.
+package stateupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Fixture is the state of a resource as written by an older release, along
+// with the state expected once it has been upgraded.
+type Fixture struct {
+	// Version is the schema version the state was written with.
+	Version int64 `json:"version"`
+	// State holds the attributes as found in the state file.
+	State json.RawMessage `json:"state"`
+	// Expected holds the attributes after the upgrade.
+	Expected json.RawMessage `json:"expected"`
+}
+
+// Replay upgrades every fixture in dir, each written with a schema version
+// older than the current one, to the current schema of the resource and
+// compares the result with the expected state. It also fails
+// when a prior schema version has no upgrader or no fixture exercising it.
+func Replay(t *testing.T, r resource.ResourceWithUpgradeState, dir string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	current := schemaResp.Schema
+	upgraders := r.UpgradeState(ctx)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatalf("listing fixtures: %s", err)
+	}
+
+	covered := make(map[int64]bool)
+	for _, file := range files {
+		var fixture Fixture
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("reading fixture %s: %s", file, err)
+		}
+		if err := json.Unmarshal(content, &fixture); err != nil {
+			t.Fatalf("decoding fixture %s: %s", file, err)
+		}
+		covered[fixture.Version] = true
+
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".json"), func(t *testing.T) {
+			expected, err := tftypes.ValueFromJSONWithOpts(fixture.Expected,
+				current.Type().TerraformType(ctx),
+				tftypes.ValueFromJSONOpts{})
+			if err != nil {
+				t.Fatalf("decoding expected state: %s", err)
+			}
+
+			// state at the current version is read as is, there is nothing
+			// to replay
+			if fixture.Version >= current.Version {
+				t.Fatalf("fixture written with version %d, not older than the current version %d",
+					fixture.Version, current.Version)
+			}
+
+			upgrader, ok := upgraders[fixture.Version]
+			if !ok {
+				t.Fatalf("no upgrader for version %d", fixture.Version)
+			}
+
+			req := resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: fixture.State},
+			}
+			if upgrader.PriorSchema != nil {
+				prior, err := tftypes.ValueFromJSONWithOpts(fixture.State,
+					upgrader.PriorSchema.Type().TerraformType(ctx),
+					tftypes.ValueFromJSONOpts{})
+				if err != nil {
+					t.Fatalf("decoding state with the prior schema: %s", err)
+				}
+				req.State = &tfsdk.State{
+					Schema: *upgrader.PriorSchema,
+					Raw:    prior,
+				}
+			}
+
+			resp := resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: current,
+					Raw:    tftypes.NewValue(current.Type().TerraformType(ctx), nil),
+				},
+			}
+			upgrader.StateUpgrader(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("upgrading state: %v", resp.Diagnostics)
+			}
+
+			assertEqual(t, expected, resp.State.Raw)
+		})
+	}
+
+	for version := int64(0); version < current.Version; version++ {
+		if _, ok := upgraders[version]; !ok {
+			t.Errorf("no upgrader for version %d", version)
+		}
+		if !covered[version] {
+			t.Errorf("no fixture for version %d in %s", version, dir)
+		}
+	}
+}
+
+func assertEqual(t *testing.T, expected, actual tftypes.Value) {
+	t.Helper()
+
+	if expected.Equal(actual) {
+		return
+	}
+
+	diffs, err := expected.Diff(actual)
+	if err != nil {
+		t.Fatalf("comparing states: %s", err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s: expected %s, got %s", diff.Path, diff.Value1, diff.Value2)
+	}
+}
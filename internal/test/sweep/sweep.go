@@ -0,0 +1,217 @@
+// Package sweep deletes the projects, regions and API keys leaked by aborted
+// acceptance test runs. Sweepers are run with
+//
+//	go test ./internal/test/sweep -v -sweep=all
+//
+// against the organization of CATALYST_API_KEY, and only delete objects
+// named like acceptance tests name them.
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	diagrid_errors "github.com/diagridio/diagrid-cloud-go/pkg/errors"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider"
+	"github.com/diagridio/terraform-provider-catalyst/internal/provider/helpers"
+)
+
+const (
+	// Timeout bounds a sweeper run, including waiting for deletions.
+	Timeout = 30 * time.Minute
+
+	// APIKeyPrefix is the prefix acceptance tests name API keys with. Keys
+	// are shared with people and CI in the organization, so the prefix is
+	// distinctive enough not to sweep theirs.
+	APIKeyPrefix = "tf-acc-key"
+)
+
+var (
+	// ProjectPrefixes are the prefixes acceptance tests name projects with.
+	ProjectPrefixes = []string{"prj"}
+	// RegionPrefixes are the prefixes acceptance tests name regions with.
+	RegionPrefixes = []string{"region"}
+	// APIKeyPrefixes are the prefixes acceptance tests name API keys with.
+	APIKeyPrefixes = []string{APIKeyPrefix}
+)
+
+// Sweepers returns the sweepers of every resource type, creating their
+// clients with newClient. Regions depend on projects, so projects are swept
+// first.
+func Sweepers(newClient func() (catalyst.Client, error)) map[string]*resource.Sweeper {
+	sweeper := func(fn func(context.Context, catalyst.Client) error) func(string) error {
+		return func(_ string) error {
+			client, err := newClient()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+			defer cancel()
+
+			return fn(ctx, client)
+		}
+	}
+
+	return map[string]*resource.Sweeper{
+		"catalyst_project": {
+			Name: "catalyst_project",
+			F:    sweeper(Projects),
+		},
+		"catalyst_region": {
+			Name:         "catalyst_region",
+			Dependencies: []string{"catalyst_project"},
+			F:            sweeper(Regions),
+		},
+		"catalyst_api_key": {
+			Name: "catalyst_api_key",
+			F:    sweeper(APIKeys),
+		},
+	}
+}
+
+// Register registers the sweepers with the test framework.
+func Register(newClient func() (catalyst.Client, error)) {
+	for name, sweeper := range Sweepers(newClient) {
+		resource.AddTestSweepers(name, sweeper)
+	}
+}
+
+// ClientFromEnv creates a client configured like the provider is from the
+// environment.
+func ClientFromEnv() (catalyst.Client, error) {
+	endpoint, ok := os.LookupEnv("CATALYST_API_ENDPOINT")
+	if !ok {
+		endpoint = provider.ProdAPIEndpoint
+	}
+
+	return catalyst.NewClient(endpoint, os.Getenv("CATALYST_API_KEY"))
+}
+
+// Projects deletes the projects named with ProjectPrefixes, waiting until
+// they are gone.
+func Projects(ctx context.Context, client catalyst.Client) error {
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+
+	var errs []error
+	for _, project := range projects {
+		name := lo.FromPtr(lo.FromPtr(project.Metadata).Name)
+		if !IsTestName(name, ProjectPrefixes) {
+			continue
+		}
+
+		log.Printf("[INFO] sweeping project %s", name)
+		if err := client.DeleteProject(ctx, name); err != nil && !diagrid_errors.IsResourceNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting project %s: %w", name, err))
+			continue
+		}
+
+		if err := helpers.WaitFor(ctx, "project "+name+" to be deleted", func(ctx context.Context) (bool, string, error) {
+			project, err := client.GetProject(ctx, name, &cloudruntime_client.DescribeProjectParams{})
+			if diagrid_errors.IsResourceNotFoundError(err) {
+				return true, "", nil
+			}
+			if err != nil {
+				return false, "", err
+			}
+
+			return false, lo.FromPtr(lo.FromPtr(project.Status).Status), nil
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("error waiting for project %s to be deleted: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Regions deletes the regions named with RegionPrefixes, waiting until they
+// are gone. Regions still holding projects fail to be deleted.
+func Regions(ctx context.Context, client catalyst.Client) error {
+	regions, err := client.ListRegions(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing regions: %w", err)
+	}
+
+	var errs []error
+	for _, region := range regions {
+		name := lo.FromPtr(lo.FromPtr(region.Metadata).Name)
+		if !IsTestName(name, RegionPrefixes) {
+			continue
+		}
+
+		log.Printf("[INFO] sweeping region %s", name)
+		if err := client.DeleteRegion(ctx, name); err != nil && !diagrid_errors.IsResourceNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting region %s: %w", name, err))
+			continue
+		}
+
+		if err := helpers.WaitFor(ctx, "region "+name+" to be deleted", func(ctx context.Context) (bool, string, error) {
+			region, err := client.GetRegion(ctx, name)
+			if diagrid_errors.IsResourceNotFoundError(err) {
+				return true, "", nil
+			}
+			if err != nil {
+				return false, "", err
+			}
+
+			return false, lo.FromPtr(lo.FromPtr(region.Status).Status), nil
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("error waiting for region %s to be deleted: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// APIKeys deletes the API keys named with APIKeyPrefixes, which are gone
+// once deleted.
+func APIKeys(ctx context.Context, client catalyst.Client) error {
+	keys, err := client.ListAPIKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing api keys: %w", err)
+	}
+
+	var errs []error
+	for _, key := range keys {
+		id := lo.FromPtr(key.Data.Id)
+		name := lo.FromPtr(lo.FromPtr(key.Data.Attributes).Name)
+		if !IsTestName(name, APIKeyPrefixes) {
+			continue
+		}
+
+		log.Printf("[INFO] sweeping api key %s (%s)", name, id)
+		if err := client.DeleteAPIKey(ctx, id); err != nil && !diagrid_errors.IsResourceNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting api key %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IsTestName reports whether name was generated by
+// acctest.RandomWithPrefix with one of the prefixes, which appends a dash
+// and a random number.
+func IsTestName(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		suffix, ok := strings.CutPrefix(name, prefix+"-")
+		if ok && suffix != "" && strings.Trim(suffix, "0123456789") == "" {
+			return true
+		}
+	}
+
+	return false
+}
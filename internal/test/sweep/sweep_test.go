@@ -0,0 +1,156 @@
+package sweep_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/samber/lo"
+
+	cloudruntime_client "github.com/diagridio/diagrid-cloud-go/pkg/cloudruntime/client"
+	conductor_client "github.com/diagridio/diagrid-cloud-go/pkg/conductor/client"
+
+	"github.com/diagridio/terraform-provider-catalyst/internal/catalyst"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/fakeapi"
+	"github.com/diagridio/terraform-provider-catalyst/internal/test/sweep"
+)
+
+func TestMain(m *testing.M) {
+	sweep.Register(sweep.ClientFromEnv)
+	resource.TestMain(m)
+}
+
+func TestIsTestName(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"prj-4239812393219":      true,
+		"prj-1":                  true,
+		"prj":                    false,
+		"prj-":                   false,
+		"prj-orders":             false,
+		"orders-prj-42":          false,
+		"region-8123912831":      false,
+		"regionHost-8123912831":  false,
+		"prj-8123912831-staging": false,
+	} {
+		if actual := sweep.IsTestName(name, sweep.ProjectPrefixes); actual != expected {
+			t.Errorf("expected IsTestName(%q) to be %t", name, expected)
+		}
+	}
+
+	for name, expected := range map[string]bool{
+		"tf-acc-key-4239812393219": true,
+		"key-4239812393219":        false,
+		"ci-key-4239812393219":     false,
+	} {
+		if actual := sweep.IsTestName(name, sweep.APIKeyPrefixes); actual != expected {
+			t.Errorf("expected IsTestName(%q) to be %t for api keys", name, expected)
+		}
+	}
+}
+
+func TestSweepers(t *testing.T) {
+	api := fakeapi.New(t)
+	api.AddRegion(*newRegion("region-1"))
+	api.AddRegion(*newRegion("production"))
+	api.AddProject(*newProject("prj-1", "region-1"))
+	api.AddProject(*newProject("prj-2", "production"))
+	api.AddProject(*newProject("orders", "production"))
+
+	sweepers := sweep.Sweepers(func() (catalyst.Client, error) {
+		return api.ClientFactory()("", "")
+	})
+	if deps := sweepers["catalyst_region"].Dependencies; !lo.Contains(deps, "catalyst_project") {
+		t.Fatalf("expected regions to be swept after projects, got dependencies %v", deps)
+	}
+
+	// regions can't be deleted while they hold projects
+	if err := sweepers["catalyst_region"].F(""); err == nil {
+		t.Errorf("expected sweeping regions holding projects to fail")
+	}
+
+	if err := sweepers["catalyst_project"].F(""); err != nil {
+		t.Fatalf("sweeping projects: %s", err)
+	}
+	if err := sweepers["catalyst_region"].F(""); err != nil {
+		t.Fatalf("sweeping regions: %s", err)
+	}
+
+	for name, kept := range map[string]bool{"prj-1": false, "prj-2": false, "orders": true} {
+		if _, ok := api.Project(name); ok != kept {
+			t.Errorf("expected project %s to be kept: %t", name, kept)
+		}
+	}
+	for name, kept := range map[string]bool{"region-1": false, "production": true} {
+		if _, ok := api.Region(name); ok != kept {
+			t.Errorf("expected region %s to be kept: %t", name, kept)
+		}
+	}
+
+	// sweeping again finds nothing left to delete
+	if err := sweepers["catalyst_project"].F(""); err != nil {
+		t.Errorf("sweeping projects again: %s", err)
+	}
+}
+
+func TestSweepAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	api := fakeapi.New(t)
+
+	c, err := api.ClientFactory()("", "")
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	// keys named like acceptance tests name them are swept, others are
+	// kept even when their name looks generated
+	swept := []string{acctest.RandomWithPrefix(sweep.APIKeyPrefix), acctest.RandomWithPrefix(sweep.APIKeyPrefix)}
+	ids := make(map[string]string)
+	for _, name := range append([]string{"key-1", "ci"}, swept...) {
+		key, err := c.CreateAPIKey(ctx, &conductor_client.APIKeyRequest{Name: lo.ToPtr(name)})
+		if err != nil {
+			t.Fatalf("creating api key %s: %s", name, err)
+		}
+		ids[name] = lo.FromPtr(key.Data.Id)
+	}
+
+	sweepers := sweep.Sweepers(func() (catalyst.Client, error) {
+		return c, nil
+	})
+	if err := sweepers["catalyst_api_key"].F(""); err != nil {
+		t.Fatalf("sweeping api keys: %s", err)
+	}
+
+	for name, kept := range map[string]bool{swept[0]: false, swept[1]: false, "key-1": true, "ci": true} {
+		if _, err := c.GetAPIKey(ctx, ids[name]); (err == nil) != kept {
+			t.Errorf("expected api key %s to be kept: %t, got %v", name, kept, err)
+		}
+	}
+}
+
+func newRegion(name string) *cloudruntime_client.Region {
+	return &cloudruntime_client.Region{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindRegion),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.RegionSpec{
+			Host:     lo.ToPtr("host"),
+			Ingress:  lo.ToPtr("https://*.example.com:443"),
+			Location: lo.ToPtr("us-west-1"),
+		},
+	}
+}
+
+func newProject(name, region string) *cloudruntime_client.Project {
+	return &cloudruntime_client.Project{
+		ApiVersion: lo.ToPtr(catalyst.CatalystDiagridV1Beta1),
+		Kind:       lo.ToPtr(catalyst.KindProject),
+		Metadata: &cloudruntime_client.Metadata{
+			Name: lo.ToPtr(name),
+		},
+		Spec: &cloudruntime_client.ProjectSpec{
+			Region: lo.ToPtr(region),
+		},
+	}
+}